@@ -9,6 +9,8 @@ import (
 
 	"runtime"
 
+	"github.com/stripe/stripe-cli/pkg/fixtures"
+	"github.com/stripe/stripe-cli/pkg/proxy"
 	"github.com/stripe/stripe-cli/pkg/validators"
 )
 
@@ -148,3 +150,61 @@ func detectShell() string {
 		return ""
 	}
 }
+
+//
+// Dynamic completions
+//
+// These functions suggest values that are only known at runtime. The
+// completion scripts call the CLI to get them as the user types.
+//
+
+// completeProfileNames suggests the profiles of the config file
+func completeProfileNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return filterPrefix(Config.GetProfileNames(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeTriggerEvents suggests the events that can be triggered, custom
+// triggers included
+func completeTriggerEvents(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return filterPrefix(fixtures.EventNames(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeEventTypes suggests event types for comma-separated lists of
+// events, e.g. `--events charge.captured,charge.up<TAB>`
+func completeEventTypes(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	prefix := ""
+	current := toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		prefix = toComplete[:i+1]
+		current = toComplete[i+1:]
+	}
+
+	selected := make(map[string]bool)
+	for _, event := range strings.Split(prefix, ",") {
+		selected[event] = true
+	}
+
+	var suggestions []string
+	for _, event := range append([]string{"*"}, proxy.EventTypes()...) {
+		if !selected[event] && strings.HasPrefix(event, current) {
+			suggestions = append(suggestions, prefix+event)
+		}
+	}
+
+	return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+func filterPrefix(values []string, prefix string) []string {
+	var filtered []string
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			filtered = append(filtered, value)
+		}
+	}
+
+	return filtered
+}
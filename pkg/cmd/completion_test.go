@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompleteEventTypes(t *testing.T) {
+	suggestions, directive := completeEventTypes(nil, nil, "charge.captured,charge.refund")
+
+	require.Equal(t, []string{"charge.captured,charge.refund.updated", "charge.captured,charge.refunded"}, suggestions)
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp|cobra.ShellCompDirectiveNoSpace, directive)
+}
+
+func TestCompleteEventTypesSkipsSelectedEvents(t *testing.T) {
+	suggestions, _ := completeEventTypes(nil, nil, "charge.captured,charge.capture")
+
+	require.Empty(t, suggestions)
+}
+
+func TestCompleteTriggerEvents(t *testing.T) {
+	suggestions, _ := completeTriggerEvents(nil, nil, "payment_intent.c")
+	require.Contains(t, suggestions, "payment_intent.created")
+	for _, suggestion := range suggestions {
+		require.Contains(t, suggestion, "payment_intent.c")
+	}
+
+	suggestions, _ = completeTriggerEvents(nil, []string{"payment_intent.created"}, "")
+	require.Empty(t, suggestions)
+}
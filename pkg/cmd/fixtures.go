@@ -1,11 +1,17 @@
 package cmd
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 
 	"github.com/stripe/stripe-cli/pkg/config"
 	"github.com/stripe/stripe-cli/pkg/fixtures"
+	"github.com/stripe/stripe-cli/pkg/requests"
 	"github.com/stripe/stripe-cli/pkg/stripe"
 	"github.com/stripe/stripe-cli/pkg/validators"
 	"github.com/stripe/stripe-cli/pkg/version"
@@ -23,6 +29,10 @@ type FixturesCmd struct {
 	override      []string
 	add           []string
 	remove        []string
+	output        string
+	outputFile    string
+	resume        string
+	report        string
 }
 
 func newFixturesCmd(cfg *config.Config) *FixturesCmd {
@@ -32,10 +42,25 @@ func newFixturesCmd(cfg *config.Config) *FixturesCmd {
 
 	fixturesCmd.Cmd = &cobra.Command{
 		Use:   "fixtures",
-		Args:  validators.ExactArgs(1),
+		Args:  validators.MaximumNArgs(1),
 		Short: "Run fixtures to populate your account with data",
-		Long:  `Run fixtures to populate your account with data`,
-		RunE:  fixturesCmd.runFixturesCmd,
+		Long: `Run fixtures to populate your account with data.
+
+Fixture files can be written in JSON or YAML, and can compose shared steps
+from other fixture files with the "include" directive. Values captured from
+the responses can be exported through the "outputs" section with --output,
+in which case the progress of the steps is printed to stderr.
+Steps can verify their responses with an "assert" list, in which case the
+command exits with an error if any assertion fails. Steps with "multipart"
+set to true upload the file whose path is given in their "file" param, and
+steps with a "stripe_account" act as that connected account, which can be
+created by a previous step (e.g. "${acct:id}").
+
+Every run is given a run ID, and its requests are sent with idempotency keys
+derived from that ID. If a step fails, the run can be continued from that step
+with --resume <run-id>, reusing the objects created by the previous steps. The
+run must be resumed with the same --stripe-account and --api-version.`,
+		RunE: fixturesCmd.runFixturesCmd,
 	}
 
 	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.stripeAccount, "stripe-account", "", "Set a header identifying the connected account")
@@ -44,40 +69,76 @@ func newFixturesCmd(cfg *config.Config) *FixturesCmd {
 	fixturesCmd.Cmd.Flags().StringArrayVar(&fixturesCmd.add, "add", []string{}, "Add parameters in the fixture")
 	fixturesCmd.Cmd.Flags().StringArrayVar(&fixturesCmd.remove, "remove", []string{}, "Remove parameters from the fixture")
 	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.apiVersion, "api-version", "", "Specify API version in the fixture")
+	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.output, "output", "", fmt.Sprintf("Export the fixture outputs in the given format (%s)", strings.Join(fixtures.OutputFormats, ", ")))
+	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.outputFile, "output-file", "", "Write the fixture outputs to a file instead of stdout")
+	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.resume, "resume", "", "Resume a failed fixture run from the step that failed")
+	fixturesCmd.Cmd.Flags().StringVar(&fixturesCmd.report, "report", "", "Write a report of the run to a file, as JUnit XML for .xml files and JSON otherwise")
+
+	fixturesCmd.Cmd.AddCommand(newFixturesGenerateCmd(cfg).Cmd)
+	fixturesCmd.Cmd.AddCommand(newFixturesExportSessionCmd().Cmd)
 
 	return fixturesCmd
 }
 
 func (fc *FixturesCmd) runFixturesCmd(cmd *cobra.Command, args []string) error {
-	version.CheckLatestVersion()
+	// Only the outputs are printed to stdout when they are written to it, so
+	// that they can be redirected or evaluated
+	if !fc.outputsToStdout() {
+		version.CheckLatestVersion()
+	}
 
 	apiKey, err := fc.Cfg.Profile.GetAPIKey(false)
 	if err != nil {
 		return err
 	}
 
-	if len(args) == 0 {
-		return nil
+	if len(args) == 0 && fc.resume == "" {
+		return fmt.Errorf("`%s` requires a fixture file, or --resume <run-id> to resume a failed run", cmd.CommandPath())
+	}
+
+	if fc.output != "" && !isOutputFormat(fc.output) {
+		return fmt.Errorf("unsupported output format: %s. Supported formats are: %s", fc.output, strings.Join(fixtures.OutputFormats, ", "))
+	}
+
+	fs := afero.NewOsFs()
+
+	run, err := fc.runState(fs, args)
+	if err != nil {
+		return err
 	}
 
 	fixture, err := fixtures.NewFixtureFromFile(
-		afero.NewOsFs(),
+		fs,
 		apiKey,
-		fc.stripeAccount,
+		run.StripeAccount,
 		stripe.DefaultAPIBaseURL,
-		args[0],
-		fc.skip,
-		fc.override,
-		fc.add,
-		fc.remove,
+		run.File,
+		run.Skip,
+		run.Override,
+		run.Add,
+		run.Remove,
 	)
 	if err != nil {
 		return err
 	}
 
-	_, err = fixture.Execute(cmd.Context(), fc.apiVersion)
+	if fc.outputsToStdout() {
+		fixture.Progress = os.Stderr
+	}
+
+	fixture.TrackRun(run)
+
+	_, err = fixture.Execute(cmd.Context(), run.APIVersion)
+
+	// The report is written for failed runs as well
+	if reportErr := fc.writeReport(run.File, fixture); reportErr != nil {
+		return reportErr
+	}
 
 	if err != nil {
+		if run.FailedStep != "" {
+			fmt.Fprintf(os.Stderr, "Fixture run %s failed at step %s. To resume it, run:\n  %s\n", run.RunID, run.FailedStep, resumeCommand(run))
+		}
 		return err
 	}
 
@@ -86,5 +147,250 @@ func (fc *FixturesCmd) runFixturesCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	return nil
+	return fc.writeOutputs(fixture)
+}
+
+// runState returns the state of the run to execute: the saved state of the
+// run to resume, or a new run of the fixture file given as argument
+func (fc *FixturesCmd) runState(fs afero.Fs, args []string) (*fixtures.RunState, error) {
+	if fc.resume == "" {
+		file := args[0]
+
+		// The run can be resumed from another directory. The paths of the
+		// embedded fixtures, such as `triggers/customer.created.json`, are
+		// kept as is.
+		if exists, _ := afero.Exists(fs, file); exists {
+			var err error
+			file, err = filepath.Abs(file)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return &fixtures.RunState{
+			RunID:         fixtures.NewRunID(),
+			File:          file,
+			StripeAccount: fc.stripeAccount,
+			APIVersion:    fc.apiVersion,
+			Skip:          fc.skip,
+			Override:      fc.override,
+			Add:           fc.add,
+			Remove:        fc.remove,
+		}, nil
+	}
+
+	if len(args) > 0 {
+		return nil, fmt.Errorf("--resume cannot be used with a fixture file, the file of the run is used")
+	}
+
+	run, err := fixtures.LoadRunState(fs, fc.resume)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := run.CheckResume(fc.stripeAccount, fc.apiVersion); err != nil {
+		return nil, err
+	}
+
+	return run, nil
+}
+
+// resumeCommand returns the command that resumes a run
+func resumeCommand(run *fixtures.RunState) string {
+	command := "stripe fixtures --resume " + run.RunID
+	if run.StripeAccount != "" {
+		command += " --stripe-account " + run.StripeAccount
+	}
+	if run.APIVersion != "" {
+		command += " --api-version " + run.APIVersion
+	}
+
+	return command
+}
+
+func (fc *FixturesCmd) writeReport(file string, fixture *fixtures.Fixture) error {
+	if fc.report == "" {
+		return nil
+	}
+
+	f, err := os.Create(fc.report)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	name := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+
+	return fixtures.WriteReport(f, fixtures.ReportFormatForFile(fc.report), name, fixture.Results())
+}
+
+func (fc *FixturesCmd) writeOutputs(fixture *fixtures.Fixture) error {
+	if fc.output == "" {
+		if fc.outputFile == "" {
+			return nil
+		}
+		fc.output = fixtures.OutputFormatJSON
+	}
+
+	if fc.outputFile == "" {
+		return fixture.WriteOutputs(os.Stdout, fc.output)
+	}
+
+	f, err := os.Create(fc.outputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return fixture.WriteOutputs(f, fc.output)
+}
+
+func (fc *FixturesCmd) outputsToStdout() bool {
+	return fc.output != "" && fc.outputFile == ""
+}
+
+func isOutputFormat(format string) bool {
+	for _, f := range fixtures.OutputFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// FixturesGenerateCmd generates a fixture file from an existing event
+type FixturesGenerateCmd struct {
+	Cmd *cobra.Command
+	Cfg *config.Config
+
+	fromEvent     string
+	stripeAccount string
+	outputFile    string
+}
+
+func newFixturesGenerateCmd(cfg *config.Config) *FixturesGenerateCmd {
+	gc := &FixturesGenerateCmd{
+		Cfg: cfg,
+	}
+
+	gc.Cmd = &cobra.Command{
+		Use:   "generate",
+		Args:  validators.NoArgs,
+		Short: "Generate a fixture from an existing event",
+		Long: `Generate a fixture that reproduces the object of an existing event.
+
+The fixture recreates the event's object along with the objects it references,
+such as its customer or product. For "*.updated" events the object is created
+with its previous values and then updated, and for "*.deleted" events it is
+created and then deleted, so that running the fixture sends the same event.
+
+The request that produced the event cannot be retrieved through the API, so
+the fixture is built from the event's object rather than from the original
+request.`,
+		Example: `stripe fixtures generate --from-event evt_123
+  stripe fixtures generate --from-event evt_123 --output-file fixture.json`,
+		RunE: gc.runFixturesGenerateCmd,
+	}
+
+	gc.Cmd.Flags().StringVar(&gc.fromEvent, "from-event", "", "ID of the event to generate the fixture from")
+	gc.Cmd.Flags().StringVar(&gc.stripeAccount, "stripe-account", "", "Set a header identifying the connected account")
+	gc.Cmd.Flags().StringVar(&gc.outputFile, "output-file", "", "Write the fixture to a file instead of stdout")
+
+	return gc
+}
+
+func (gc *FixturesGenerateCmd) runFixturesGenerateCmd(cmd *cobra.Command, args []string) error {
+	if gc.fromEvent == "" {
+		return fmt.Errorf("an event ID is required, use --from-event to set it")
+	}
+
+	apiKey, err := gc.Cfg.Profile.GetAPIKey(false)
+	if err != nil {
+		return err
+	}
+
+	generator := fixtures.FixtureGenerator{
+		APIKey:        apiKey,
+		StripeAccount: gc.stripeAccount,
+		BaseURL:       stripe.DefaultAPIBaseURL,
+	}
+
+	data, source, err := generator.GenerateFromEvent(cmd.Context(), gc.fromEvent)
+	if err != nil {
+		return err
+	}
+
+	note := fmt.Sprintf("Generated fixture from %s (%s)", source.EventID, source.EventType)
+	if source.RequestID != "" {
+		note += fmt.Sprintf(", originally triggered by request %s", source.RequestID)
+	}
+	fmt.Fprintln(os.Stderr, note)
+
+	if gc.outputFile == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	return os.WriteFile(gc.outputFile, data, 0644)
+}
+
+// FixturesExportSessionCmd turns a recorded session log into a fixture file
+type FixturesExportSessionCmd struct {
+	Cmd *cobra.Command
+
+	sessionLog  string
+	outputFile  string
+	includeGets bool
+}
+
+func newFixturesExportSessionCmd() *FixturesExportSessionCmd {
+	ec := &FixturesExportSessionCmd{}
+
+	ec.Cmd = &cobra.Command{
+		Use:   "export-session",
+		Args:  validators.NoArgs,
+		Short: "Turn the requests recorded in a session into a fixture",
+		Long: fmt.Sprintf(`Turn the requests recorded in a session into a fixture.
+
+Requests are recorded when the %s environment variable is set to the path of
+a session log file: every request made with "stripe get", "stripe post",
+"stripe delete" or the resource commands is appended to it. Each recorded
+request becomes a fixture step, and IDs returned by earlier requests are
+rewritten as references to the step that created them.`, requests.SessionLogEnv),
+		Example: fmt.Sprintf(`export %s=./session.log
+  stripe customers create --email jenny.rosen@example.com
+  stripe payment_intents create --customer cus_123 --amount 2000 --currency usd
+  stripe fixtures export-session --output-file fixture.json`, requests.SessionLogEnv),
+		RunE: ec.runFixturesExportSessionCmd,
+	}
+
+	ec.Cmd.Flags().StringVar(&ec.sessionLog, "session-log", os.Getenv(requests.SessionLogEnv), "The session log file to export")
+	ec.Cmd.Flags().StringVar(&ec.outputFile, "output-file", "", "Write the fixture to a file instead of stdout")
+	ec.Cmd.Flags().BoolVar(&ec.includeGets, "include-gets", false, "Also export the GET requests of the session")
+
+	return ec
+}
+
+func (ec *FixturesExportSessionCmd) runFixturesExportSessionCmd(cmd *cobra.Command, args []string) error {
+	if ec.sessionLog == "" {
+		return fmt.Errorf("a session log is required, use --session-log or set %s", requests.SessionLogEnv)
+	}
+
+	f, err := os.Open(ec.sessionLog)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	data, err := fixtures.ExportSession(f, ec.includeGets)
+	if err != nil {
+		return err
+	}
+
+	if ec.outputFile == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+
+	return os.WriteFile(ec.outputFile, data, 0644)
 }
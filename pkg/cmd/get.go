@@ -24,10 +24,25 @@ func newGetCmd() *getCmd {
 		Short: "Retrieve resources by their ID or make GET requests",
 		Long: `With the get command, you can load API resources by providing just the resource
 id. You can also make normal HTTP GET requests to the Stripe API by providing
-the API path.`,
+the API path.
+
+Use --all to retrieve every object of a list, or --max-items to retrieve up to
+that many objects. The pages are merged into a single JSON array, or printed
+as one object per line with --format ndjson.
+
+Responses can be printed as json, yaml, table, csv or ndjson with --format.
+--fields selects the fields to print (of each object for lists) and --select
+selects part of the response, both as gjson paths. With --all and --max-items,
+--select applies to each object of the list rather than to the response, as in
+--select amount instead of --select data.#.amount.`,
 		Example: `stripe get ch_1EGYgUByst5pquEtjb0EkYha
   stripe get cus_G6GQwbr1dWXt9O
-  stripe get /v1/charges --limit 50`,
+  stripe get /v1/charges --limit 50
+  stripe get /v1/customers --all --format ndjson
+  stripe get /v1/invoices --max-items 250
+  stripe get /v1/customers --format table --fields id,email,created
+  stripe get /v1/charges --select data.#.amount
+  stripe get /v1/charges --all --select amount`,
 		RunE: gc.reqs.RunRequestsCmd,
 	}
 
@@ -26,13 +26,24 @@ func newPostCmd() *postCmd {
 
 The post command supports API features like idempotency keys and expand flags.
 
+With --from-file, a request is made for every row of a CSV or NDJSON file,
+whose columns are used as params. Placeholders in the path such as {id} are
+filled in from the columns of the same name. The result of every row is
+written to a results file, and --resume skips the rows that already succeeded.
+
+Nested params can be given as a JSON or YAML object with --data-file, or read
+from stdin with --data -.
+
 For a full list of supported paths, see the API reference:
 https://stripe.com/docs/api
 `,
 		Example: `stripe post /payment_intents \
     -d amount=2000 \
     -d currency=usd \
-    -d "payment_method_types[]=card"`,
+    -d "payment_method_types[]=card"
+  stripe post /v1/checkout/sessions --data-file session.json
+  echo '{"email": "jenny@example.com"}' | stripe post /v1/customers --data -
+  stripe post /v1/customers/{id} --from-file customers.csv --concurrency 8`,
 		RunE: gc.reqs.RunRequestsCmd,
 	}
 
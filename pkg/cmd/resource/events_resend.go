@@ -28,9 +28,9 @@ func (erc *EventsResendCmd) runEventsResendCmd(cmd *cobra.Command, args []string
 // NewEventsResendCmd returns a new EventsResendCmd.
 func NewEventsResendCmd(parentCmd *cobra.Command, cfg *config.Config) *EventsResendCmd {
 	eventsResendCmd := &EventsResendCmd{
-		opCmd: NewOperationCmd(parentCmd, "resend", "/v1/events/{event}/retry", http.MethodPost, map[string]string{
-			"account":          "string",
-			"webhook_endpoint": "string",
+		opCmd: NewOperationCmd(parentCmd, "resend", "/v1/events/{event}/retry", http.MethodPost, map[string]Flag{
+			"account":          {Type: "string"},
+			"webhook_endpoint": {Type: "string"},
 		}, cfg),
 	}
 
@@ -0,0 +1,236 @@
+package resource
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+// Types of the flags of operation commands, named after the types of the
+// OpenAPI spec
+const (
+	FlagTypeString  = "string"
+	FlagTypeInteger = "integer"
+	FlagTypeNumber  = "number"
+	FlagTypeBoolean = "boolean"
+	FlagTypeArray   = "array"
+	FlagTypeObject  = "object"
+)
+
+// Flag describes a param of an operation that is exposed as a flag.
+//
+// Boolean flags take a value, as in `--livemode true`, so that they can be
+// unset with `--livemode false`. Array flags can be repeated and send
+// `name[]=value` params, and object flags take `key=value` pairs and send
+// `name[key]=value` params, as for metadata. Enum values are checked before
+// the request is sent and offered as shell completions.
+//
+// The generator builds the flags of the resource commands from the OpenAPI
+// spec with FlagFromSchema, which the interactive mode also uses for the
+// params of the spec, so that both describe params the same way.
+type Flag struct {
+	Type        string
+	Description string
+	Enum        []string
+
+	// Required is set for the params that the operation requires, which
+	// the interactive mode prompts for first
+	Required bool
+}
+
+// FlagFromSchema returns the flag for a param of an operation, if the
+// param can be set with a flag: scalars, lists of scalars, and free-form
+// objects such as metadata. For polymorphic params, the first scalar
+// alternative is used.
+func FlagFromSchema(schema *spec.Schema) *Flag {
+	if scalarSchema := getScalarSchema(schema); scalarSchema != nil {
+		return &Flag{
+			Type: scalarSchema.Type,
+			Enum: getEnum(scalarSchema),
+		}
+	}
+
+	for _, subSchema := range append([]*spec.Schema{schema}, schema.AnyOf...) {
+		switch {
+		case subSchema.Type == spec.TypeArray && subSchema.Items != nil && getScalarSchema(subSchema.Items) != nil:
+			return &Flag{Type: FlagTypeArray}
+		case subSchema.Type == spec.TypeObject && len(subSchema.Properties) == 0 && subSchema.AdditionalProperties != nil:
+			return &Flag{Type: FlagTypeObject}
+		}
+	}
+
+	return nil
+}
+
+// usage returns the help text of the flag
+func (f Flag) usage() string {
+	usage := f.Description
+
+	var hint string
+	switch {
+	case len(f.enum()) > 0:
+		hint = "one of: " + strings.Join(f.enum(), ", ")
+	case f.Type == FlagTypeArray:
+		hint = "can be repeated"
+	case f.Type == FlagTypeObject:
+		hint = "key=value pairs"
+	}
+
+	if hint == "" {
+		return usage
+	}
+	if usage == "" {
+		return hint
+	}
+
+	return fmt.Sprintf("%s (%s)", usage, hint)
+}
+
+// define adds the flag to the flag set
+func (f Flag) define(flags *pflag.FlagSet, name string) {
+	usage := f.usage()
+
+	switch f.Type {
+	case FlagTypeInteger:
+		flags.Int64(name, 0, usage)
+	case FlagTypeNumber:
+		flags.Float64(name, 0, usage)
+	case FlagTypeArray:
+		flags.StringArray(name, []string{}, usage)
+	case FlagTypeObject:
+		flags.StringToString(name, map[string]string{}, usage)
+	default:
+		flags.String(name, "", usage)
+	}
+}
+
+// registerCompletion offers the enum values of the flag as completions
+func (f Flag) registerCompletion(cmd *cobra.Command, name string) {
+	enum := f.enum()
+	if len(enum) == 0 {
+		return
+	}
+
+	cmd.RegisterFlagCompletionFunc(name, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) { // #nosec G104
+		return enum, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// enum returns the values accepted by the flag, if it only accepts some
+func (f Flag) enum() []string {
+	if f.Type == FlagTypeBoolean {
+		return []string{"true", "false"}
+	}
+
+	return f.Enum
+}
+
+// params returns the params to send for the value of the flag
+func (f Flag) params(flags *pflag.FlagSet, flagName, paramName string) ([]string, error) {
+	switch f.Type {
+	case FlagTypeArray:
+		values, err := flags.GetStringArray(flagName)
+		if err != nil {
+			return nil, err
+		}
+
+		params := make([]string, 0, len(values))
+		for _, value := range values {
+			params = append(params, fmt.Sprintf("%s[]=%s", paramName, value))
+		}
+		return params, nil
+	case FlagTypeObject:
+		values, err := flags.GetStringToString(flagName)
+		if err != nil {
+			return nil, err
+		}
+
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		params := make([]string, 0, len(values))
+		for _, key := range keys {
+			params = append(params, fmt.Sprintf("%s[%s]=%s", paramName, key, values[key]))
+		}
+		return params, nil
+	case FlagTypeNumber:
+		value, err := flags.GetFloat64(flagName)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%s=%s", paramName, strconv.FormatFloat(value, 'f', -1, 64))}, nil
+	default:
+		value := flags.Lookup(flagName).Value.String()
+
+		if enum := f.enum(); len(enum) > 0 && !isEnumValue(enum, value) {
+			return nil, fmt.Errorf("invalid value %q for --%s: must be one of %s", value, flagName, strings.Join(enum, ", "))
+		}
+
+		return []string{fmt.Sprintf("%s=%s", paramName, value)}, nil
+	}
+}
+
+func isEnumValue(enum []string, value string) bool {
+	for _, e := range enum {
+		if e == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getScalarSchema returns the schema itself if it's scalar, or the first of
+// its scalar alternatives if it's polymorphic.
+//
+// Strings that only support the "" (empty string) value are used to unset
+// params and are not considered scalar, so that no flag is created for
+// them.
+func getScalarSchema(schema *spec.Schema) *spec.Schema {
+	if len(schema.AnyOf) > 0 {
+		for _, subSchema := range schema.AnyOf {
+			if scalarSchema := getScalarSchema(subSchema); scalarSchema != nil {
+				return scalarSchema
+			}
+		}
+		return nil
+	}
+
+	switch schema.Type {
+	case FlagTypeString:
+		if len(schema.Enum) == 1 && schema.Enum[0] == "" {
+			return nil
+		}
+		return schema
+	case FlagTypeBoolean, FlagTypeInteger, FlagTypeNumber:
+		return schema
+	default:
+		return nil
+	}
+}
+
+// getEnum returns the values of a string enum, without the "" value used to
+// unset params
+func getEnum(schema *spec.Schema) []string {
+	if schema.Type != FlagTypeString {
+		return nil
+	}
+
+	var enum []string
+	for _, value := range schema.Enum {
+		if s, ok := value.(string); ok && s != "" {
+			enum = append(enum, s)
+		}
+	}
+
+	return enum
+}
@@ -0,0 +1,34 @@
+package resource
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlagUsage(t *testing.T) {
+	require.Equal(t, "The customer's email.", Flag{Type: "string", Description: "The customer's email."}.usage())
+	require.Equal(t, "Tax status (one of: exempt, none)", Flag{Type: "string", Description: "Tax status", Enum: []string{"exempt", "none"}}.usage())
+	require.Equal(t, "one of: true, false", Flag{Type: "boolean"}.usage())
+	require.Equal(t, "Preferred languages (can be repeated)", Flag{Type: "array", Description: "Preferred languages"}.usage())
+	require.Equal(t, "Metadata (key=value pairs)", Flag{Type: "object", Description: "Metadata"}.usage())
+}
+
+func TestFlagCompletion(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "stripe"}
+	cmd := &cobra.Command{Use: "foo", Run: func(*cobra.Command, []string) {}}
+	rootCmd.AddCommand(cmd)
+
+	flag := Flag{Type: "string", Enum: []string{"exempt", "none"}}
+	flag.define(cmd.Flags(), "tax-exempt")
+	flag.registerCompletion(cmd, "tax-exempt")
+
+	var out bytes.Buffer
+	rootCmd.SetOut(&out)
+	rootCmd.SetArgs([]string{cobra.ShellCompRequestCmd, "foo", "--tax-exempt", ""})
+	require.NoError(t, rootCmd.Execute())
+
+	require.Equal(t, "exempt\nnone\n:4\n", out.String())
+}
@@ -0,0 +1,440 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+
+	"github.com/stripe/stripe-cli/pkg/ansi"
+	"github.com/stripe/stripe-cli/pkg/requests"
+)
+
+// In interactive mode, operation commands prompt for the URL params that
+// were not given as arguments, then for the params of the operation that
+// were not set with flags or --data: the required ones first, then the
+// optional ones that the user picks. The params come from the OpenAPI spec
+// when one is available, and from the flags of the command otherwise. The
+// encoded request, with all its params, is shown before it is sent.
+
+const (
+	sendRequestItem = "Send the request"
+	otherValueItem  = "Enter another value"
+)
+
+var errRequestNotConfirmed = errors.New("request not confirmed")
+
+// prompter asks the user for values
+type prompter interface {
+	Select(label string, items []string, details []string) (string, error)
+	Input(label string, validate func(string) error) (string, error)
+	Confirm(label string) (bool, error)
+}
+
+// interactiveParam is a param that can be set in interactive mode
+type interactiveParam struct {
+	name        string
+	description string
+	required    bool
+	flag        Flag
+}
+
+// promptRequest prompts for the missing URL params and for the params of
+// the request that are not set yet, which are added to the params of the
+// command. It returns the URL params.
+func (oc *OperationCmd) promptRequest(p prompter, args []string) ([]string, error) {
+	args = append([]string{}, args...)
+	for _, urlParam := range oc.URLParams[len(args):] {
+		name := strings.Trim(urlParam, "{}")
+		value, err := oc.promptID(p, name, oc.urlParamObjectType(urlParam), true)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, value)
+	}
+
+	var data []string
+	var optional []interactiveParam
+
+	for _, param := range oc.interactiveParams() {
+		if isParamSet(oc.Parameters.Data(), param.name) {
+			continue
+		}
+
+		if !param.required {
+			optional = append(optional, param)
+			continue
+		}
+
+		values, err := oc.promptParam(p, param)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, values...)
+	}
+
+	for len(optional) > 0 {
+		items := []string{sendRequestItem}
+		details := []string{""}
+		for _, param := range optional {
+			items = append(items, fmt.Sprintf("%s (%s)", param.name, param.flag.Type))
+			details = append(details, param.description)
+		}
+
+		selected, err := p.Select("Add a param", items, details)
+		if err != nil {
+			return nil, err
+		}
+		if selected == sendRequestItem {
+			break
+		}
+
+		for i, param := range optional {
+			if items[i+1] != selected {
+				continue
+			}
+
+			values, err := oc.promptParam(p, param)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, values...)
+
+			optional = append(optional[:i], optional[i+1:]...)
+			break
+		}
+	}
+
+	oc.Parameters.AppendData(data)
+
+	confirmed, err := oc.previewRequest(p, args)
+	if err != nil {
+		return nil, err
+	}
+	if !confirmed {
+		return nil, errRequestNotConfirmed
+	}
+
+	return args, nil
+}
+
+// interactiveParams returns the params of the operation from the OpenAPI
+// spec, or from the flags of the command if the spec is not available,
+// required params first
+func (oc *OperationCmd) interactiveParams() []interactiveParam {
+	var params []interactiveParam
+
+	if api := requests.OpenAPISpec(); api != nil {
+		for _, param := range api.OperationParams(oc.HTTPVerb, oc.Path) {
+			flag := FlagFromSchema(param.Schema)
+			if flag == nil {
+				continue
+			}
+
+			params = append(params, interactiveParam{
+				name:        param.Name,
+				description: param.Description,
+				required:    param.Required,
+				flag:        *flag,
+			})
+		}
+
+		if len(params) > 0 {
+			return params
+		}
+	}
+
+	for flagName, flag := range oc.propFlags {
+		params = append(params, interactiveParam{
+			name:        strings.ReplaceAll(flagName, "-", "_"),
+			description: flag.Description,
+			required:    flag.Required,
+			flag:        flag,
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].required != params[j].required {
+			return params[i].required
+		}
+		return params[i].name < params[j].name
+	})
+
+	return params
+}
+
+// promptParam prompts for the value of a param and returns its form
+// encoded params
+func (oc *OperationCmd) promptParam(p prompter, param interactiveParam) ([]string, error) {
+	label := param.name
+	if param.required {
+		label += " (required)"
+	}
+
+	if enum := param.flag.enum(); len(enum) > 0 {
+		value, err := p.Select(label, enum, nil)
+		if err != nil {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%s=%s", param.name, value)}, nil
+	}
+
+	switch param.flag.Type {
+	case FlagTypeArray:
+		value, err := p.Input(label+", comma-separated", requiredValue(param.required))
+		if err != nil {
+			return nil, err
+		}
+
+		var params []string
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				params = append(params, fmt.Sprintf("%s[]=%s", param.name, item))
+			}
+		}
+		return params, nil
+	case FlagTypeObject:
+		var params []string
+		for {
+			key, err := p.Input(fmt.Sprintf("%s key (leave empty to finish)", param.name), nil)
+			if err != nil {
+				return nil, err
+			}
+			if key == "" {
+				return params, nil
+			}
+
+			value, err := p.Input(fmt.Sprintf("%s[%s]", param.name, key), nil)
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, fmt.Sprintf("%s[%s]=%s", param.name, key, value))
+		}
+	case FlagTypeInteger, FlagTypeNumber:
+		value, err := p.Input(label, func(value string) error {
+			if value == "" && !param.required {
+				return nil
+			}
+			if _, err := strconv.ParseFloat(value, 64); err != nil || (param.flag.Type == FlagTypeInteger && strings.ContainsAny(value, ".eE")) {
+				return fmt.Errorf("%s expects a %s", param.name, param.flag.Type)
+			}
+			return nil
+		})
+		if err != nil || value == "" {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%s=%s", param.name, value)}, nil
+	default:
+		value, err := oc.promptID(p, label, idObjectType(param.name), param.required)
+		if err != nil || (value == "" && !param.required) {
+			return nil, err
+		}
+		return []string{fmt.Sprintf("%s=%s", param.name, value)}, nil
+	}
+}
+
+// promptID prompts for a value, offering the recent IDs of the objects of
+// a type when there are some
+func (oc *OperationCmd) promptID(p prompter, label, objectType string, required bool) (string, error) {
+	if ids := oc.recentIDs(objectType); len(ids) > 0 {
+		selected, err := p.Select(label, append(ids, otherValueItem), nil)
+		if err != nil || selected != otherValueItem {
+			return selected, err
+		}
+	}
+
+	return p.Input(label, requiredValue(required))
+}
+
+// recentIDs returns the recent IDs of the objects of a type. For types
+// guessed from param names such as `default_payment_method`, the leading
+// words are dropped until a type with recent IDs is found.
+func (oc *OperationCmd) recentIDs(objectType string) []string {
+	if oc.Profile == nil {
+		return nil
+	}
+
+	for objectType != "" {
+		if ids := requests.RecentObjectIDs(oc.Profile.ProfileName, objectType); len(ids) > 0 {
+			return ids
+		}
+
+		i := strings.Index(objectType, "_")
+		if i < 0 {
+			break
+		}
+		objectType = objectType[i+1:]
+	}
+
+	return nil
+}
+
+// urlParamObjectType returns the type of the objects that a URL param
+// identifies, from the collection that precedes it in the path, e.g.
+// `payment_intent` for `/v1/payment_intents/{intent}` and
+// `issuing.card` for `/v1/issuing/cards/{card}`. When the path has no
+// collection before the param, the type is guessed from the name of the
+// param.
+func (oc *OperationCmd) urlParamObjectType(urlParam string) string {
+	segments := strings.Split(strings.Trim(oc.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment != urlParam || i < 2 || strings.HasPrefix(segments[i-1], "{") {
+			continue
+		}
+
+		objectType := singular(segments[i-1])
+		if namespace := segments[i-2]; i >= 3 && namespace != "test_helpers" && !strings.HasPrefix(namespace, "{") {
+			objectType = namespace + "." + objectType
+		}
+
+		return objectType
+	}
+
+	if name := strings.Trim(urlParam, "{}"); name != "id" {
+		return name
+	}
+
+	return ""
+}
+
+func singular(resource string) string {
+	switch {
+	case strings.HasSuffix(resource, "ies"):
+		return strings.TrimSuffix(resource, "ies") + "y"
+	case strings.HasSuffix(resource, "sses"):
+		return strings.TrimSuffix(resource, "es")
+	default:
+		return strings.TrimSuffix(resource, "s")
+	}
+}
+
+// previewRequest shows the encoded request and asks whether to send it
+func (oc *OperationCmd) previewRequest(p prompter, args []string) (bool, error) {
+	encoded, err := oc.BuildDataForRequest(&oc.Parameters)
+	if err != nil {
+		return false, err
+	}
+
+	request := fmt.Sprintf("%s %s", oc.HTTPVerb, formatURL(oc.Path, args))
+	if encoded != "" {
+		if oc.HTTPVerb == http.MethodPost {
+			request += "\n\n" + encoded
+		} else {
+			request += "?" + encoded
+		}
+	}
+
+	fmt.Printf("\n%s\n\n", ansi.Bold(request))
+
+	return p.Confirm("Send this request")
+}
+
+// idObjectType returns the type of the objects that a param may identify,
+// e.g. `customer` for `customer` or `customer_id`. The type is only a
+// guess: params that are not IDs have no recent IDs.
+func idObjectType(name string) string {
+	if i := strings.LastIndex(name, "["); i >= 0 {
+		name = strings.TrimSuffix(name[i+1:], "]")
+	}
+
+	return strings.TrimSuffix(name, "_id")
+}
+
+// isParamSet returns whether a param, or one of its nested params such as
+// `metadata[plan]` or `expand[]`, is set in form encoded params
+func isParamSet(data []string, name string) bool {
+	for _, datum := range data {
+		key := strings.SplitN(datum, "=", 2)[0]
+		if key == name || strings.HasPrefix(key, name+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isStdinData returns whether --data reads the params from stdin
+func isStdinData(data []string) bool {
+	for _, datum := range data {
+		if datum == "-" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func requiredValue(required bool) func(string) error {
+	return func(value string) error {
+		if required && strings.TrimSpace(value) == "" {
+			return errors.New("a value is required")
+		}
+		return nil
+	}
+}
+
+// promptuiPrompter prompts in the terminal
+type promptuiPrompter struct{}
+
+func (promptuiPrompter) Select(label string, items []string, details []string) (string, error) {
+	type item struct {
+		Name    string
+		Details string
+	}
+
+	promptItems := make([]item, len(items))
+	for i, name := range items {
+		promptItems[i] = item{Name: name}
+		if i < len(details) {
+			promptItems[i].Details = details[i]
+		}
+	}
+
+	prompt := promptui.Select{
+		Label: label,
+		Items: promptItems,
+		Size:  10,
+		Templates: &promptui.SelectTemplates{
+			Active:   "▸ {{ .Name | bold }}",
+			Inactive: "  {{ .Name }}",
+			Selected: ansi.Faint(fmt.Sprintf("✔ %s: {{ .Name | bold }}", label)),
+			Details:  "{{ if .Details }}{{ .Details | faint }}{{ end }}",
+		},
+		Searcher: func(input string, index int) bool {
+			return strings.Contains(strings.ToLower(promptItems[index].Name), strings.ToLower(input))
+		},
+	}
+
+	i, _, err := prompt.Run()
+	if err != nil {
+		return "", err
+	}
+
+	return items[i], nil
+}
+
+func (promptuiPrompter) Input(label string, validate func(string) error) (string, error) {
+	prompt := promptui.Prompt{
+		Label:    label,
+		Validate: validate,
+	}
+
+	return prompt.Run()
+}
+
+func (promptuiPrompter) Confirm(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:     label,
+		IsConfirm: true,
+	}
+
+	_, err := prompt.Run()
+	if errors.Is(err, promptui.ErrAbort) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
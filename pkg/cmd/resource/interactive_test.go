@@ -0,0 +1,280 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+)
+
+// fakePrompter answers the prompts with scripted answers, in order
+type fakePrompter struct {
+	t       *testing.T
+	answers []string
+	labels  []string
+}
+
+func (p *fakePrompter) next(label string) string {
+	require.NotEmpty(p.t, p.answers, "unexpected prompt %q", label)
+	p.labels = append(p.labels, label)
+	answer := p.answers[0]
+	p.answers = p.answers[1:]
+	return answer
+}
+
+func (p *fakePrompter) Select(label string, items []string, details []string) (string, error) {
+	answer := p.next(label)
+	require.Contains(p.t, items, answer)
+	return answer, nil
+}
+
+func (p *fakePrompter) Input(label string, validate func(string) error) (string, error) {
+	answer := p.next(label)
+	if validate != nil {
+		if err := validate(answer); err != nil {
+			return "", err
+		}
+	}
+	return answer, nil
+}
+
+func (p *fakePrompter) Confirm(label string) (bool, error) {
+	return p.next(label) == "y", nil
+}
+
+func TestRunOperationCmd_Interactive(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		require.Equal(t, "/v1/bars/bar_123", r.URL.Path)
+		vals, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		require.Equal(t, []string{"exempt"}, vals["tax_exempt"])
+		require.Equal(t, []string{"pro"}, vals["metadata[plan]"])
+		require.Equal(t, []string{"10"}, vals["amount"])
+		require.Equal(t, 3, len(vals))
+
+		w.Write([]byte(`{"id": "bar_123"}`))
+	}))
+	defer ts.Close()
+
+	viper.Reset()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"amount":     {Type: "integer"},
+		"metadata":   {Type: "object"},
+		"tax_exempt": {Type: "string", Enum: []string{"exempt", "none"}},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+	oc.APIBaseURL = ts.URL
+
+	prompter := &fakePrompter{t: t, answers: []string{
+		"bar_123",
+		"tax_exempt (string)", "exempt",
+		"metadata (object)", "plan", "pro", "",
+		"amount (integer)", "10",
+		"y",
+	}}
+	oc.prompter = prompter
+
+	parentCmd.SetArgs([]string{"foo", "--interactive"})
+	err := parentCmd.ExecuteContext(context.Background())
+
+	require.NoError(t, err)
+	require.Empty(t, prompter.answers)
+}
+
+func TestRunOperationCmd_InteractiveSetParams(t *testing.T) {
+	sent := false
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sent = true
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		vals, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		require.Equal(t, url.Values{"amount": {"10"}, "currency": {"usd"}, "metadata[plan]": {"pro"}}, vals)
+
+		w.Write([]byte(`{"id": "bar_123"}`))
+	}))
+	defer ts.Close()
+
+	viper.Reset()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars", http.MethodPost, map[string]Flag{
+		"amount":   {Type: "integer", Required: true},
+		"currency": {Type: "string", Required: true},
+		"metadata": {Type: "object"},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+	oc.APIBaseURL = ts.URL
+
+	// The params set with flags or --data are not prompted for, and the
+	// required params are prompted for first
+	prompter := &fakePrompter{t: t, answers: []string{"usd", "y"}}
+	oc.prompter = prompter
+
+	parentCmd.SetArgs([]string{"foo", "--interactive", "--amount", "10", "-d", "metadata[plan]=pro"})
+	err := parentCmd.ExecuteContext(context.Background())
+
+	require.NoError(t, err)
+	require.True(t, sent)
+	require.Equal(t, []string{"currency (required)", "Send this request"}, prompter.labels)
+}
+
+func TestRunOperationCmd_InteractiveStdinData(t *testing.T) {
+	viper.Reset()
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars", http.MethodPost, map[string]Flag{}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+
+	require.NoError(t, oc.Cmd.ParseFlags([]string{"--interactive", "--data", "-"}))
+	err := oc.validateArgs(oc.Cmd, nil)
+
+	require.EqualError(t, err, "--interactive can't be used with --data -")
+}
+
+func TestRunOperationCmd_InteractiveNotConfirmed(t *testing.T) {
+	viper.Reset()
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"amount": {Type: "integer"},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+	oc.APIBaseURL = "http://127.0.0.1:1"
+	oc.prompter = &fakePrompter{t: t, answers: []string{sendRequestItem, "n"}}
+
+	parentCmd.SetArgs([]string{"foo", "bar_123", "--interactive"})
+	err := parentCmd.ExecuteContext(context.Background())
+
+	require.NoError(t, err)
+}
+
+func TestPromptRequestRecentIDs(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	require.NoError(t, os.MkdirAll(filepath.Join(configHome, "stripe"), 0755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(configHome, "stripe", "recent_object_ids.json"),
+		[]byte(`{"default": {"customer": ["cus_2", "cus_1"], "payment_method": ["pm_1"]}}`),
+		0600,
+	))
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/customers/{customer}", http.MethodPost, map[string]Flag{
+		"default_payment_method": {Type: "string"},
+	}, &config.Config{
+		Profile: config.Profile{ProfileName: "default"},
+	})
+
+	prompter := &fakePrompter{t: t, answers: []string{
+		"cus_1",
+		"default_payment_method (string)", otherValueItem, "pm_2",
+		"y",
+	}}
+
+	args, err := oc.promptRequest(prompter, nil)
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"cus_1"}, args)
+	require.Equal(t, []string{"default_payment_method=pm_2"}, oc.Parameters.Data())
+	require.Equal(t, []string{"customer", "Add a param", "default_payment_method", "default_payment_method", "Send this request"}, prompter.labels)
+}
+
+func TestURLParamObjectType(t *testing.T) {
+	for path, expected := range map[string]string{
+		"/v1/customers/{customer}":                         "customer",
+		"/v1/orders/{id}":                                  "order",
+		"/v1/issuing/cardholders/{id}":                     "issuing.cardholder",
+		"/v1/test_helpers/entities/{id}":                   "entity",
+		"/v1/customers/{customer}/{id}":                    "",
+		"/v1/customers/{customer}/{source}":                "source",
+		"/v1/terminal/readers/{id}/taxes":                  "terminal.reader",
+		"/v1/payment_intents/{intent}":                     "payment_intent",
+		"/v1/checkout/sessions/{session}":                  "checkout.session",
+		"/v1/radar/value_list_items/{item}":                "radar.value_list_item",
+		"/v1/subscriptions/{subscription_exposed_id}":      "subscription",
+		"/v1/subscription_schedules/{schedule}":            "subscription_schedule",
+		"/v1/terminal/readers/{reader}":                    "terminal.reader",
+		"/v1/issuing/cardholders/{cardholder}":             "issuing.cardholder",
+		"/v1/radar/value_lists/{value_list}":               "radar.value_list",
+		"/v1/test_helpers/terminal/readers/{reader}/taxes": "terminal.reader",
+		"/v1/accounts/{account}/capabilities/{capability}": "capability",
+	} {
+		oc := &OperationCmd{Path: path}
+		urlParams := extractURLParams(path)
+		require.Equal(t, expected, oc.urlParamObjectType(urlParams[len(urlParams)-1]), fmt.Sprintf("path %s", path))
+	}
+}
+
+func TestURLParamObjectTypeGeneratedPaths(t *testing.T) {
+	content, err := os.ReadFile(filepath.Join("..", "resources_cmds.go"))
+	require.NoError(t, err)
+
+	paths := regexp.MustCompile(`"(/v1/[^"]*\{[^"]*)"`).FindAllStringSubmatch(string(content), -1)
+	require.NotEmpty(t, paths)
+
+	// Every URL param of the generated commands is preceded by the
+	// collection of the objects it identifies
+	for _, match := range paths {
+		oc := &OperationCmd{Path: match[1]}
+		for _, urlParam := range extractURLParams(oc.Path) {
+			objectType := oc.urlParamObjectType(urlParam)
+			require.NotEmpty(t, objectType, "%s in %s", urlParam, oc.Path)
+			require.NotContains(t, objectType, "{", "%s in %s", urlParam, oc.Path)
+			require.NotContains(t, objectType, "test_helpers", "%s in %s", urlParam, oc.Path)
+		}
+	}
+}
+
+func TestCompleteArgs(t *testing.T) {
+	configHome := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", configHome)
+	require.NoError(t, os.MkdirAll(filepath.Join(configHome, "stripe"), 0755))
+	require.NoError(t, os.WriteFile(
+		filepath.Join(configHome, "stripe", "recent_object_ids.json"),
+		[]byte(`{"default": {"customer": ["cus_2", "cus_1"], "source": ["src_1"]}}`),
+		0600,
+	))
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/customers/{customer}/sources/{id}", http.MethodGet, map[string]Flag{}, &config.Config{
+		Profile: config.Profile{ProfileName: "default"},
+	})
+
+	ids, directive := oc.completeArgs(oc.Cmd, nil, "")
+	require.Equal(t, []string{"cus_2", "cus_1"}, ids)
+	require.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+
+	ids, _ = oc.completeArgs(oc.Cmd, nil, "cus_1")
+	require.Equal(t, []string{"cus_1"}, ids)
+
+	ids, _ = oc.completeArgs(oc.Cmd, []string{"cus_1"}, "")
+	require.Equal(t, []string{"src_1"}, ids)
+
+	ids, _ = oc.completeArgs(oc.Cmd, []string{"cus_1", "src_1"}, "")
+	require.Empty(t, ids)
+}
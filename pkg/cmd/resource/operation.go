@@ -1,9 +1,11 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -32,9 +34,12 @@ type OperationCmd struct {
 	Path      string
 	URLParams []string
 
-	stringFlags map[string]*string
+	// propFlags maps the names of the flags of the request params to their
+	// definitions
+	propFlags map[string]Flag
 
-	data []string
+	interactive bool
+	prompter    prompter
 }
 
 func (oc *OperationCmd) runOperationCmd(cmd *cobra.Command, args []string) error {
@@ -43,33 +48,59 @@ func (oc *OperationCmd) runOperationCmd(cmd *cobra.Command, args []string) error
 		return err
 	}
 
-	path := formatURL(oc.Path, args)
-
 	flagParams := make([]string, 0)
+	flagParamNames := make(map[string]bool)
 
-	for stringProp, stringVal := range oc.stringFlags {
-		// only include fields explicitly set by the user to avoid conflicts between e.g. account_balance, balance
-		if oc.Cmd.Flags().Changed(stringProp) {
-			paramName := strings.ReplaceAll(stringProp, "-", "_")
-			flagParams = append(flagParams, fmt.Sprintf("%s=%s", paramName, *stringVal))
-		}
+	flagNames := make([]string, 0, len(oc.propFlags))
+	for flagName := range oc.propFlags {
+		flagNames = append(flagNames, flagName)
 	}
+	sort.Strings(flagNames)
 
-	for _, datum := range oc.data {
-		split := strings.SplitN(datum, "=", 2)
-		if len(split) < 2 {
-			return fmt.Errorf("Invalid data argument: %s", datum)
+	for _, flagName := range flagNames {
+		// only include fields explicitly set by the user to avoid conflicts between e.g. account_balance, balance
+		if !oc.Cmd.Flags().Changed(flagName) {
+			continue
 		}
 
-		if _, ok := oc.stringFlags[split[0]]; ok {
-			return fmt.Errorf("Flag \"%s\" already set", split[0])
+		paramName := strings.ReplaceAll(flagName, "-", "_")
+		params, err := oc.propFlags[flagName].params(oc.Cmd.Flags(), flagName, paramName)
+		if err != nil {
+			return err
 		}
+		flagParams = append(flagParams, params...)
+		flagParamNames[paramName] = true
+	}
+
+	// The params of --data and --data-file are loaded first so that the ones
+	// also set with flags aren't sent twice
+	if err := oc.LoadData(&oc.Parameters); err != nil {
+		return err
+	}
 
-		flagParams = append(flagParams, datum)
+	for _, datum := range oc.Parameters.Data() {
+		if name := topLevelParam(datum); flagParamNames[name] {
+			return fmt.Errorf("Flag \"%s\" already set", strings.ReplaceAll(name, "_", "-"))
+		}
 	}
 
 	oc.Parameters.AppendData(flagParams)
 
+	// The prompts skip the params set with flags or --data, and the request
+	// is previewed with all its params
+	if oc.interactive {
+		args, err = oc.promptRequest(oc.prompter, args)
+		if errors.Is(err, errRequestNotConfirmed) {
+			fmt.Println("Exiting without execution. User did not confirm the command.")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	path := formatURL(oc.Path, args)
+
 	if oc.HTTPVerb == http.MethodDelete {
 		// display account information and confirm whether user wants to proceed
 		var mode = "Test"
@@ -94,23 +125,69 @@ func (oc *OperationCmd) runOperationCmd(cmd *cobra.Command, args []string) error
 			fmt.Println("Exiting without execution. User did not confirm the command.")
 			return nil
 		}
+	}
 
-		// if confirmation is provided, make the request
-		_, err = oc.MakeRequest(cmd.Context(), apiKey, path, &oc.Parameters, false)
+	if oc.Bulk() {
+		return oc.MakeBulkRequests(cmd.Context(), apiKey, oc.Path, &oc.Parameters)
+	}
 
+	if err := oc.ValidateParams(path, &oc.Parameters); err != nil {
 		return err
 	}
-	// else
+
+	if oc.Paginating() {
+		return oc.MakePaginatedRequest(cmd.Context(), apiKey, path, &oc.Parameters)
+	}
+
 	_, err = oc.MakeRequest(cmd.Context(), apiKey, path, &oc.Parameters, false)
 	return err
 }
 
+// validateArgs expects an argument per URL param, except for bulk requests
+// which take the URL params from the file, and for interactive requests
+// which prompt for the missing ones
+func (oc *OperationCmd) validateArgs(cmd *cobra.Command, args []string) error {
+	if oc.Bulk() {
+		if oc.interactive {
+			return fmt.Errorf("--interactive can't be used with --from-file")
+		}
+		return validators.NoArgs(cmd, args)
+	}
+
+	if oc.interactive {
+		// The prompts can't read from stdin once the params were read from it
+		if data, _ := cmd.Flags().GetStringArray("data"); isStdinData(data) {
+			return fmt.Errorf("--interactive can't be used with --data -")
+		}
+		return validators.MaximumNArgs(len(oc.URLParams))(cmd, args)
+	}
+
+	return validators.ExactArgs(len(oc.URLParams))(cmd, args)
+}
+
+// completeArgs suggests the recent IDs of the objects that the URL params
+// identify
+func (oc *OperationCmd) completeArgs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) >= len(oc.URLParams) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var ids []string
+	for _, id := range oc.recentIDs(oc.urlParamObjectType(oc.URLParams[len(args)])) {
+		if strings.HasPrefix(id, toComplete) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
 //
 // Public functions
 //
 
 // NewOperationCmd returns a new OperationCmd.
-func NewOperationCmd(parentCmd *cobra.Command, name, path, httpVerb string, propFlags map[string]string, cfg *config.Config) *OperationCmd {
+func NewOperationCmd(parentCmd *cobra.Command, name, path, httpVerb string, propFlags map[string]Flag, cfg *config.Config) *OperationCmd {
 	urlParams := extractURLParams(path)
 	httpVerb = strings.ToUpper(httpVerb)
 	operationCmd := &OperationCmd{
@@ -123,23 +200,28 @@ func NewOperationCmd(parentCmd *cobra.Command, name, path, httpVerb string, prop
 		Path:      path,
 		URLParams: urlParams,
 
-		stringFlags: make(map[string]*string),
+		propFlags: make(map[string]Flag),
+		prompter:  promptuiPrompter{},
 	}
 	cmd := &cobra.Command{
-		Use:         name,
-		Annotations: make(map[string]string),
-		RunE:        operationCmd.runOperationCmd,
-		Args:        validators.ExactArgs(len(urlParams)),
+		Use:               name,
+		Annotations:       make(map[string]string),
+		RunE:              operationCmd.runOperationCmd,
+		Args:              operationCmd.validateArgs,
+		ValidArgsFunction: operationCmd.completeArgs,
 	}
 
-	for prop := range propFlags {
-		// it's ok to treat all flags as string flags because we don't send any default flag values to the API
-		// i.e. "account_balance" default is "" not 0 but this is ok
+	for prop, flag := range propFlags {
+		// default flag values are never sent to the API, only the values of the flags set by the user
 		flagName := strings.ReplaceAll(prop, "_", "-")
-		operationCmd.stringFlags[flagName] = cmd.Flags().String(flagName, "", "")
+		operationCmd.propFlags[flagName] = flag
+		flag.define(cmd.Flags(), flagName)
+		flag.registerCompletion(cmd, flagName)
 		cmd.Flags().SetAnnotation(flagName, "request", []string{"true"})
 	}
 
+	cmd.Flags().BoolVar(&operationCmd.interactive, "interactive", false, "Build the request by answering prompts for its params")
+
 	cmd.SetUsageTemplate(operationUsageTemplate(urlParams))
 	cmd.DisableFlagsInUseLine = true
 	operationCmd.Cmd = cmd
@@ -160,6 +242,17 @@ func extractURLParams(path string) []string {
 	return re.FindAllString(path, -1)
 }
 
+// topLevelParam returns the name of the top-level param that a param sets,
+// e.g. `metadata` for `metadata[plan]=pro`
+func topLevelParam(datum string) string {
+	name := strings.SplitN(datum, "=", 2)[0]
+	if i := strings.Index(name, "["); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
 func formatURL(path string, urlParams []string) string {
 	s := make([]interface{}, len(urlParams))
 	for i, v := range urlParams {
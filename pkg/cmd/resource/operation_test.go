@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -18,7 +20,7 @@ import (
 func TestNewOperationCmd(t *testing.T) {
 	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
 
-	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodGet, map[string]string{}, &config.Config{})
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodGet, map[string]Flag{}, &config.Config{})
 
 	require.Equal(t, "foo", oc.Name)
 	require.Equal(t, "/v1/bars/{id}", oc.Path)
@@ -55,10 +57,10 @@ func TestRunOperationCmd(t *testing.T) {
 	profile := config.Profile{
 		APIKey: "sk_test_1234",
 	}
-	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]string{
-		"param1":                 "string",
-		"param2":                 "string",
-		"param_with_underscores": "string",
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"param1":                 {Type: "string"},
+		"param2":                 {Type: "string"},
+		"param_with_underscores": {Type: "string"},
 	}, &config.Config{
 		Profile: profile,
 	})
@@ -97,8 +99,8 @@ func TestRunOperationCmd_ExtraParams(t *testing.T) {
 	profile := config.Profile{
 		APIKey: "sk_test_1234",
 	}
-	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]string{
-		"param1": "string",
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"param1": {Type: "string"},
 	}, &config.Config{
 		Profile: profile,
 	})
@@ -118,12 +120,119 @@ func TestRunOperationCmd_NoAPIKey(t *testing.T) {
 	viper.Reset()
 
 	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
-	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]string{
-		"param1": "string",
-		"param2": "string",
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"param1": {Type: "string"},
+		"param2": {Type: "string"},
 	}, &config.Config{})
 
 	err := oc.runOperationCmd(oc.Cmd, []string{"bar_123", "param1=value1", "param2=value2"})
 
 	require.Error(t, err, "your API key has not been configured. Use `stripe login` to set your API key")
 }
+
+func TestRunOperationCmd_FromFile(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"id": "bar_123"}`))
+	}))
+	defer ts.Close()
+
+	viper.Reset()
+
+	input := filepath.Join(t.TempDir(), "bars.csv")
+	require.NoError(t, os.WriteFile(input, []byte("id,param1\nbar_123,value1\n"), 0600))
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodPost, map[string]Flag{
+		"param1": {Type: "string"},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+	oc.APIBaseURL = ts.URL
+
+	parentCmd.SetArgs([]string{"foo", "--from-file", input})
+	err := parentCmd.ExecuteContext(context.Background())
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"/v1/bars/bar_123"}, paths)
+}
+
+func TestRunOperationCmd_TypedFlags(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		vals, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+		require.Equal(t, []string{"10"}, vals["amount"])
+		require.Equal(t, []string{"true"}, vals["capture"])
+		require.Equal(t, []string{"fr", "en"}, vals["locales[]"])
+		require.Equal(t, []string{"pro"}, vals["metadata[plan]"])
+		require.Equal(t, []string{"1"}, vals["metadata[seats]"])
+		require.Equal(t, []string{"exempt"}, vals["tax_exempt"])
+	}))
+	defer ts.Close()
+
+	viper.Reset()
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars", http.MethodPost, map[string]Flag{
+		"amount":     {Type: "integer"},
+		"capture":    {Type: "boolean"},
+		"locales":    {Type: "array"},
+		"metadata":   {Type: "object"},
+		"tax_exempt": {Type: "string", Enum: []string{"exempt", "none"}},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+	oc.APIBaseURL = ts.URL
+
+	parentCmd.SetArgs([]string{"foo",
+		"--amount", "10",
+		"--capture", "true",
+		"--locales", "fr", "--locales", "en",
+		"--metadata", "plan=pro,seats=1",
+		"--tax-exempt", "exempt",
+	})
+	err := parentCmd.ExecuteContext(context.Background())
+
+	require.NoError(t, err)
+}
+
+func TestRunOperationCmd_InvalidEnumValue(t *testing.T) {
+	viper.Reset()
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars", http.MethodPost, map[string]Flag{
+		"tax_exempt": {Type: "string", Enum: []string{"exempt", "none"}},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+
+	oc.Cmd.Flags().Set("tax-exempt", "reverse")
+	err := oc.runOperationCmd(oc.Cmd, []string{})
+
+	require.EqualError(t, err, `invalid value "reverse" for --tax-exempt: must be one of exempt, none`)
+}
+
+func TestRunOperationCmd_DataFileConflict(t *testing.T) {
+	viper.Reset()
+
+	file := filepath.Join(t.TempDir(), "params.yaml")
+	require.NoError(t, os.WriteFile(file, []byte("shipping:\n  name: Jenny\n"), 0600))
+
+	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
+	oc := NewOperationCmd(parentCmd, "foo", "/v1/bars", http.MethodPost, map[string]Flag{
+		"shipping": {Type: "object"},
+	}, &config.Config{
+		Profile: config.Profile{APIKey: "sk_test_1234"},
+	})
+
+	oc.Cmd.Flags().Set("shipping", "name=Jenny")
+	oc.Cmd.Flags().Set("data-file", file)
+	err := oc.runOperationCmd(oc.Cmd, []string{})
+
+	require.EqualError(t, err, `Flag "shipping" already set`)
+}
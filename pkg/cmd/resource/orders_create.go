@@ -21,11 +21,11 @@ func (occ *OrdersCreateCmd) runOrdersCreateCmd(cmd *cobra.Command, args []string
 // NewOrdersCreateCmd creates a new orders creation sub command.
 func NewOrdersCreateCmd(parentCmd *cobra.Command, cfg *config.Config) *OrdersCreateCmd {
 	ordersCreateCmd := &OrdersCreateCmd{
-		opCmd: NewOperationCmd(parentCmd, "create", "/v1/orders", http.MethodPost, map[string]string{
-			"currency":               "string",
-			"line_items[][product]":  "string",
-			"line_items[][quantity]": "integer",
-			"automatic_tax[enabled]": "boolean",
+		opCmd: NewOperationCmd(parentCmd, "create", "/v1/orders", http.MethodPost, map[string]Flag{
+			"currency":               {Type: "string", Required: true},
+			"line_items[][product]":  {Type: "string"},
+			"line_items[][quantity]": {Type: "integer"},
+			"automatic_tax[enabled]": {Type: "boolean"},
 		}, cfg),
 	}
 
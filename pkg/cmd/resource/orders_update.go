@@ -21,10 +21,10 @@ func (ouc *OrdersUpdateCmd) runOrdersUpdateCmd(cmd *cobra.Command, args []string
 // NewOrdersUpdateCmd creates a new orders creation sub command.
 func NewOrdersUpdateCmd(parentCmd *cobra.Command, cfg *config.Config) *OrdersUpdateCmd {
 	ordersUpdateCmd := &OrdersUpdateCmd{
-		opCmd: NewOperationCmd(parentCmd, "update", "/v1/orders/{id}", http.MethodPost, map[string]string{
-			"currency":               "string",
-			"line_items[][product]":  "string",
-			"line_items[][quantity]": "integer",
+		opCmd: NewOperationCmd(parentCmd, "update", "/v1/orders/{id}", http.MethodPost, map[string]Flag{
+			"currency":               {Type: "string"},
+			"line_items[][product]":  {Type: "string"},
+			"line_items[][quantity]": {Type: "integer"},
 		}, cfg),
 	}
 
@@ -146,1900 +146,1900 @@ func addAllResourcesCmds(rootCmd *cobra.Command) {
 	rTreasuryTransactionsCmd := resource.NewResourceCmd(nsTreasuryCmd.Cmd, "transactions")
 
 	// Operation commands
-	resource.NewOperationCmd(rAccountLinksCmd.Cmd, "create", "/v1/account_links", http.MethodPost, map[string]string{
-		"account":     "string",
-		"collect":     "string",
-		"refresh_url": "string",
-		"return_url":  "string",
-		"type":        "string",
-	}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "capabilities", "/v1/accounts/{account}/capabilities", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "create", "/v1/accounts", http.MethodPost, map[string]string{
-		"account_token":    "string",
-		"business_type":    "string",
-		"country":          "string",
-		"default_currency": "string",
-		"email":            "string",
-		"external_account": "string",
-		"type":             "string",
-	}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "delete", "/v1/accounts/{account}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "list", "/v1/accounts", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "persons", "/v1/accounts/{account}/persons", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "reject", "/v1/accounts/{account}/reject", http.MethodPost, map[string]string{
-		"reason": "string",
-	}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "retrieve", "/v1/account", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rAccountsCmd.Cmd, "update", "/v1/accounts/{account}", http.MethodPost, map[string]string{
-		"account_token":    "string",
-		"business_type":    "string",
-		"default_currency": "string",
-		"email":            "string",
-		"external_account": "string",
-	}, &Config)
-	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "create", "/v1/apple_pay/domains", http.MethodPost, map[string]string{
-		"domain_name": "string",
-	}, &Config)
-	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "delete", "/v1/apple_pay/domains/{domain}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "list", "/v1/apple_pay/domains", http.MethodGet, map[string]string{
-		"domain_name":    "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "retrieve", "/v1/apple_pay/domains/{domain}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rApplicationFeesCmd.Cmd, "list", "/v1/application_fees", http.MethodGet, map[string]string{
-		"charge":         "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rApplicationFeesCmd.Cmd, "retrieve", "/v1/application_fees/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rBalanceCmd.Cmd, "retrieve", "/v1/balance", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rBalanceTransactionsCmd.Cmd, "list", "/v1/balance_transactions", http.MethodGet, map[string]string{
-		"available_on":   "integer",
-		"created":        "integer",
-		"currency":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payout":         "string",
-		"source":         "string",
-		"starting_after": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rBalanceTransactionsCmd.Cmd, "retrieve", "/v1/balance_transactions/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "delete", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "update", "/v1/customers/{customer}/sources/{id}", http.MethodPost, map[string]string{
-		"account_holder_name": "string",
-		"account_holder_type": "string",
-		"address_city":        "string",
-		"address_country":     "string",
-		"address_line1":       "string",
-		"address_line2":       "string",
-		"address_state":       "string",
-		"address_zip":         "string",
-		"exp_month":           "string",
-		"exp_year":            "string",
-		"name":                "string",
-	}, &Config)
-	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "verify", "/v1/customers/{customer}/sources/{id}/verify", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "list", "/v1/accounts/{account}/capabilities", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "retrieve", "/v1/accounts/{account}/capabilities/{capability}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "update", "/v1/accounts/{account}/capabilities/{capability}", http.MethodPost, map[string]string{
-		"requested": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rCardsCmd.Cmd, "delete", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCardsCmd.Cmd, "update", "/v1/customers/{customer}/sources/{id}", http.MethodPost, map[string]string{
-		"account_holder_name": "string",
-		"account_holder_type": "string",
-		"address_city":        "string",
-		"address_country":     "string",
-		"address_line1":       "string",
-		"address_line2":       "string",
-		"address_state":       "string",
-		"address_zip":         "string",
-		"exp_month":           "string",
-		"exp_year":            "string",
-		"name":                "string",
-	}, &Config)
-	resource.NewOperationCmd(rCashBalancesCmd.Cmd, "retrieve", "/v1/customers/{customer}/cash_balance", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCashBalancesCmd.Cmd, "update", "/v1/customers/{customer}/cash_balance", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "capture", "/v1/charges/{charge}/capture", http.MethodPost, map[string]string{
-		"amount":                      "integer",
-		"application_fee":             "integer",
-		"application_fee_amount":      "integer",
-		"receipt_email":               "string",
-		"statement_descriptor":        "string",
-		"statement_descriptor_suffix": "string",
-		"transfer_group":              "string",
-	}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "create", "/v1/charges", http.MethodPost, map[string]string{
-		"amount":                      "integer",
-		"application_fee":             "integer",
-		"application_fee_amount":      "integer",
-		"capture":                     "boolean",
-		"currency":                    "string",
-		"customer":                    "string",
-		"description":                 "string",
-		"on_behalf_of":                "string",
-		"receipt_email":               "string",
-		"source":                      "string",
-		"statement_descriptor":        "string",
-		"statement_descriptor_suffix": "string",
-		"transfer_group":              "string",
-	}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "list", "/v1/charges", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_intent": "string",
-		"starting_after": "string",
-		"transfer_group": "string",
-	}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "retrieve", "/v1/charges/{charge}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "search", "/v1/charges/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rChargesCmd.Cmd, "update", "/v1/charges/{charge}", http.MethodPost, map[string]string{
-		"customer":       "string",
-		"description":    "string",
-		"receipt_email":  "string",
-		"transfer_group": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCountrySpecsCmd.Cmd, "list", "/v1/country_specs", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCountrySpecsCmd.Cmd, "retrieve", "/v1/country_specs/{country}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCouponsCmd.Cmd, "create", "/v1/coupons", http.MethodPost, map[string]string{
-		"amount_off":         "integer",
-		"currency":           "string",
-		"duration":           "string",
-		"duration_in_months": "integer",
-		"id":                 "string",
-		"max_redemptions":    "integer",
-		"name":               "string",
-		"percent_off":        "number",
-		"redeem_by":          "integer",
-	}, &Config)
-	resource.NewOperationCmd(rCouponsCmd.Cmd, "delete", "/v1/coupons/{coupon}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCouponsCmd.Cmd, "list", "/v1/coupons", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCouponsCmd.Cmd, "retrieve", "/v1/coupons/{coupon}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCouponsCmd.Cmd, "update", "/v1/coupons/{coupon}", http.MethodPost, map[string]string{
-		"name": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNoteLineItemsCmd.Cmd, "list", "/v1/credit_notes/{credit_note}/lines", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "create", "/v1/credit_notes", http.MethodPost, map[string]string{
-		"amount":             "integer",
-		"credit_amount":      "integer",
-		"invoice":            "string",
-		"memo":               "string",
-		"out_of_band_amount": "integer",
-		"reason":             "string",
-		"refund":             "string",
-		"refund_amount":      "integer",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "list", "/v1/credit_notes", http.MethodGet, map[string]string{
-		"customer":       "string",
-		"ending_before":  "string",
-		"invoice":        "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "preview", "/v1/credit_notes/preview", http.MethodGet, map[string]string{
-		"amount":             "integer",
-		"credit_amount":      "integer",
-		"invoice":            "string",
-		"memo":               "string",
-		"out_of_band_amount": "integer",
-		"reason":             "string",
-		"refund":             "string",
-		"refund_amount":      "integer",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "preview_lines", "/v1/credit_notes/preview/lines", http.MethodGet, map[string]string{
-		"amount":             "integer",
-		"credit_amount":      "integer",
-		"ending_before":      "string",
-		"invoice":            "string",
-		"limit":              "integer",
-		"memo":               "string",
-		"out_of_band_amount": "integer",
-		"reason":             "string",
-		"refund":             "string",
-		"refund_amount":      "integer",
-		"starting_after":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "retrieve", "/v1/credit_notes/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "update", "/v1/credit_notes/{id}", http.MethodPost, map[string]string{
-		"memo": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "void_credit_note", "/v1/credit_notes/{id}/void", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "create", "/v1/customers/{customer}/balance_transactions", http.MethodPost, map[string]string{
-		"amount":      "integer",
-		"currency":    "string",
-		"description": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "list", "/v1/customers/{customer}/balance_transactions", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "retrieve", "/v1/customers/{customer}/balance_transactions/{transaction}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "update", "/v1/customers/{customer}/balance_transactions/{transaction}", http.MethodPost, map[string]string{
-		"description": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomerCashBalanceTransactionsCmd.Cmd, "list", "/v1/customers/{customer}/cash_balance_transactions", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomerCashBalanceTransactionsCmd.Cmd, "retrieve", "/v1/customers/{customer}/cash_balance_transactions/{transaction}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "balance_transactions", "/v1/customers/{customer}/balance_transactions", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "create", "/v1/customers", http.MethodPost, map[string]string{
-		"balance":               "integer",
-		"coupon":                "string",
-		"description":           "string",
-		"email":                 "string",
-		"invoice_prefix":        "string",
-		"name":                  "string",
-		"next_invoice_sequence": "integer",
-		"payment_method":        "string",
-		"phone":                 "string",
-		"promotion_code":        "string",
-		"source":                "string",
-		"tax_exempt":            "string",
-		"test_clock":            "string",
-		"validate":              "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "create_funding_instructions", "/v1/customers/{customer}/funding_instructions", http.MethodPost, map[string]string{
-		"currency":     "string",
-		"funding_type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "delete", "/v1/customers/{customer}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "delete_discount", "/v1/customers/{customer}/discount", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "list", "/v1/customers", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"email":          "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"test_clock":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "list_payment_methods", "/v1/customers/{customer}/payment_methods", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "retrieve", "/v1/customers/{customer}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "retrieve_payment_method", "/v1/customers/{customer}/payment_methods/{payment_method}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "search", "/v1/customers/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersCmd.Cmd, "update", "/v1/customers/{customer}", http.MethodPost, map[string]string{
-		"balance":               "integer",
-		"coupon":                "string",
-		"default_source":        "string",
-		"description":           "string",
-		"email":                 "string",
-		"invoice_prefix":        "string",
-		"name":                  "string",
-		"next_invoice_sequence": "integer",
-		"phone":                 "string",
-		"promotion_code":        "string",
-		"source":                "string",
-		"tax_exempt":            "string",
-		"validate":              "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rCustomersTestHelpersCmd.Cmd, "fund_cash_balance", "/v1/test_helpers/customers/{customer}/fund_cash_balance", http.MethodPost, map[string]string{
-		"amount":    "integer",
-		"currency":  "string",
-		"reference": "string",
-	}, &Config)
-	resource.NewOperationCmd(rDisputesCmd.Cmd, "close", "/v1/disputes/{dispute}/close", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rDisputesCmd.Cmd, "list", "/v1/disputes", http.MethodGet, map[string]string{
-		"charge":         "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_intent": "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rDisputesCmd.Cmd, "retrieve", "/v1/disputes/{dispute}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rDisputesCmd.Cmd, "update", "/v1/disputes/{dispute}", http.MethodPost, map[string]string{
-		"submit": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rEphemeralKeysCmd.Cmd, "create", "/v1/ephemeral_keys", http.MethodPost, map[string]string{
-		"customer":     "string",
-		"issuing_card": "string",
-	}, &Config)
-	resource.NewOperationCmd(rEphemeralKeysCmd.Cmd, "delete", "/v1/ephemeral_keys/{key}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rEventsCmd.Cmd, "list", "/v1/events", http.MethodGet, map[string]string{
-		"created":          "integer",
-		"delivery_success": "boolean",
-		"ending_before":    "string",
-		"limit":            "integer",
-		"starting_after":   "string",
-		"type":             "string",
-	}, &Config)
-	resource.NewOperationCmd(rEventsCmd.Cmd, "retrieve", "/v1/events/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rExchangeRatesCmd.Cmd, "list", "/v1/exchange_rates", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rExchangeRatesCmd.Cmd, "retrieve", "/v1/exchange_rates/{rate_id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "create", "/v1/accounts/{account}/external_accounts", http.MethodPost, map[string]string{
-		"default_for_currency": "boolean",
-		"external_account":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "delete", "/v1/accounts/{account}/external_accounts/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "list", "/v1/accounts/{account}/external_accounts", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "retrieve", "/v1/accounts/{account}/external_accounts/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "update", "/v1/accounts/{account}/external_accounts/{id}", http.MethodPost, map[string]string{
-		"account_holder_name":  "string",
-		"account_holder_type":  "string",
-		"account_type":         "string",
-		"address_city":         "string",
-		"address_country":      "string",
-		"address_line1":        "string",
-		"address_line2":        "string",
-		"address_state":        "string",
-		"address_zip":          "string",
-		"default_for_currency": "boolean",
-		"exp_month":            "string",
-		"exp_year":             "string",
-		"name":                 "string",
-	}, &Config)
-	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "create", "/v1/application_fees/{id}/refunds", http.MethodPost, map[string]string{
-		"amount": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "list", "/v1/application_fees/{id}/refunds", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "retrieve", "/v1/application_fees/{fee}/refunds/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "update", "/v1/application_fees/{fee}/refunds/{id}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFileLinksCmd.Cmd, "create", "/v1/file_links", http.MethodPost, map[string]string{
-		"expires_at": "integer",
-		"file":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rFileLinksCmd.Cmd, "list", "/v1/file_links", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"expired":        "boolean",
-		"file":           "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFileLinksCmd.Cmd, "retrieve", "/v1/file_links/{link}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFileLinksCmd.Cmd, "update", "/v1/file_links/{link}", http.MethodPost, map[string]string{
-		"expires_at": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFilesCmd.Cmd, "create", "/v1/files", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFilesCmd.Cmd, "list", "/v1/files", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"purpose":        "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFilesCmd.Cmd, "retrieve", "/v1/files/{file}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "create", "/v1/invoiceitems", http.MethodPost, map[string]string{
-		"amount":              "integer",
-		"currency":            "string",
-		"customer":            "string",
-		"description":         "string",
-		"discountable":        "boolean",
-		"invoice":             "string",
-		"price":               "string",
-		"quantity":            "integer",
-		"subscription":        "string",
-		"unit_amount":         "integer",
-		"unit_amount_decimal": "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "delete", "/v1/invoiceitems/{invoiceitem}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "list", "/v1/invoiceitems", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"invoice":        "string",
-		"limit":          "integer",
-		"pending":        "boolean",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "retrieve", "/v1/invoiceitems/{invoiceitem}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "update", "/v1/invoiceitems/{invoiceitem}", http.MethodPost, map[string]string{
-		"amount":              "integer",
-		"description":         "string",
-		"discountable":        "boolean",
-		"price":               "string",
-		"quantity":            "integer",
-		"unit_amount":         "integer",
-		"unit_amount_decimal": "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "create", "/v1/invoices", http.MethodPost, map[string]string{
-		"application_fee_amount":         "integer",
-		"auto_advance":                   "boolean",
-		"collection_method":              "string",
-		"currency":                       "string",
-		"customer":                       "string",
-		"days_until_due":                 "integer",
-		"default_payment_method":         "string",
-		"default_source":                 "string",
-		"description":                    "string",
-		"due_date":                       "integer",
-		"footer":                         "string",
-		"on_behalf_of":                   "string",
-		"pending_invoice_items_behavior": "string",
-		"statement_descriptor":           "string",
-		"subscription":                   "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "delete", "/v1/invoices/{invoice}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "finalize_invoice", "/v1/invoices/{invoice}/finalize", http.MethodPost, map[string]string{
-		"auto_advance": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "list", "/v1/invoices", http.MethodGet, map[string]string{
-		"collection_method": "string",
-		"created":           "integer",
-		"customer":          "string",
-		"due_date":          "integer",
-		"ending_before":     "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-		"subscription":      "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "mark_uncollectible", "/v1/invoices/{invoice}/mark_uncollectible", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "pay", "/v1/invoices/{invoice}/pay", http.MethodPost, map[string]string{
-		"forgive":          "boolean",
-		"mandate":          "string",
-		"off_session":      "boolean",
-		"paid_out_of_band": "boolean",
-		"payment_method":   "string",
-		"source":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "retrieve", "/v1/invoices/{invoice}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "search", "/v1/invoices/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "send_invoice", "/v1/invoices/{invoice}/send", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "upcoming", "/v1/invoices/upcoming", http.MethodGet, map[string]string{
-		"coupon":                            "string",
-		"currency":                          "string",
-		"customer":                          "string",
-		"schedule":                          "string",
-		"subscription":                      "string",
-		"subscription_billing_cycle_anchor": "string",
-		"subscription_cancel_at":            "integer",
-		"subscription_cancel_at_period_end": "boolean",
-		"subscription_cancel_now":           "boolean",
-		"subscription_proration_behavior":   "string",
-		"subscription_proration_date":       "integer",
-		"subscription_start_date":           "integer",
-		"subscription_trial_end":            "string",
-		"subscription_trial_from_plan":      "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "upcomingLines", "/v1/invoices/upcoming/lines", http.MethodGet, map[string]string{
-		"coupon":                            "string",
-		"currency":                          "string",
-		"customer":                          "string",
-		"ending_before":                     "string",
-		"limit":                             "integer",
-		"schedule":                          "string",
-		"starting_after":                    "string",
-		"subscription":                      "string",
-		"subscription_billing_cycle_anchor": "string",
-		"subscription_cancel_at":            "integer",
-		"subscription_cancel_at_period_end": "boolean",
-		"subscription_cancel_now":           "boolean",
-		"subscription_proration_behavior":   "string",
-		"subscription_proration_date":       "integer",
-		"subscription_start_date":           "integer",
-		"subscription_trial_end":            "string",
-		"subscription_trial_from_plan":      "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "update", "/v1/invoices/{invoice}", http.MethodPost, map[string]string{
-		"application_fee_amount": "integer",
-		"auto_advance":           "boolean",
-		"collection_method":      "string",
-		"days_until_due":         "integer",
-		"default_payment_method": "string",
-		"default_source":         "string",
-		"description":            "string",
-		"due_date":               "integer",
-		"footer":                 "string",
-		"on_behalf_of":           "string",
-		"statement_descriptor":   "string",
-	}, &Config)
-	resource.NewOperationCmd(rInvoicesCmd.Cmd, "void_invoice", "/v1/invoices/{invoice}/void", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rLineItemsCmd.Cmd, "list", "/v1/invoices/{invoice}/lines", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rLoginLinksCmd.Cmd, "create", "/v1/accounts/{account}/login_links", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rMandatesCmd.Cmd, "retrieve", "/v1/mandates/{mandate}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "cancel", "/v1/orders/{id}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "create", "/v1/orders", http.MethodPost, map[string]string{
-		"currency":    "string",
-		"customer":    "string",
-		"description": "string",
-		"ip_address":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "list", "/v1/orders", http.MethodGet, map[string]string{
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "list_line_items", "/v1/orders/{id}/line_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "reopen", "/v1/orders/{id}/reopen", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "retrieve", "/v1/orders/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "submit", "/v1/orders/{id}/submit", http.MethodPost, map[string]string{
-		"expected_total": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rOrdersCmd.Cmd, "update", "/v1/orders/{id}", http.MethodPost, map[string]string{
-		"currency":    "string",
-		"customer":    "string",
-		"description": "string",
-		"ip_address":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "apply_customer_balance", "/v1/payment_intents/{intent}/apply_customer_balance", http.MethodPost, map[string]string{
-		"amount":   "integer",
-		"currency": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "cancel", "/v1/payment_intents/{intent}/cancel", http.MethodPost, map[string]string{
-		"cancellation_reason": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "capture", "/v1/payment_intents/{intent}/capture", http.MethodPost, map[string]string{
-		"amount_to_capture":           "integer",
-		"application_fee_amount":      "integer",
-		"statement_descriptor":        "string",
-		"statement_descriptor_suffix": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "confirm", "/v1/payment_intents/{intent}/confirm", http.MethodPost, map[string]string{
-		"capture_method":           "string",
-		"error_on_requires_action": "boolean",
-		"mandate":                  "string",
-		"off_session":              "boolean",
-		"payment_method":           "string",
-		"receipt_email":            "string",
-		"return_url":               "string",
-		"setup_future_usage":       "string",
-		"use_stripe_sdk":           "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "create", "/v1/payment_intents", http.MethodPost, map[string]string{
-		"amount":                      "integer",
-		"application_fee_amount":      "integer",
-		"capture_method":              "string",
-		"confirm":                     "boolean",
-		"confirmation_method":         "string",
-		"currency":                    "string",
-		"customer":                    "string",
-		"description":                 "string",
-		"error_on_requires_action":    "boolean",
-		"mandate":                     "string",
-		"off_session":                 "boolean",
-		"on_behalf_of":                "string",
-		"payment_method":              "string",
-		"receipt_email":               "string",
-		"return_url":                  "string",
-		"setup_future_usage":          "string",
-		"statement_descriptor":        "string",
-		"statement_descriptor_suffix": "string",
-		"transfer_group":              "string",
-		"use_stripe_sdk":              "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "increment_authorization", "/v1/payment_intents/{intent}/increment_authorization", http.MethodPost, map[string]string{
-		"amount":                 "integer",
-		"application_fee_amount": "integer",
-		"description":            "string",
-		"statement_descriptor":   "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "list", "/v1/payment_intents", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "retrieve", "/v1/payment_intents/{intent}", http.MethodGet, map[string]string{
-		"client_secret": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "search", "/v1/payment_intents/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "update", "/v1/payment_intents/{intent}", http.MethodPost, map[string]string{
-		"amount":                      "integer",
-		"application_fee_amount":      "integer",
-		"capture_method":              "string",
-		"currency":                    "string",
-		"customer":                    "string",
-		"description":                 "string",
-		"payment_method":              "string",
-		"receipt_email":               "string",
-		"setup_future_usage":          "string",
-		"statement_descriptor":        "string",
-		"statement_descriptor_suffix": "string",
-		"transfer_group":              "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "verify_microdeposits", "/v1/payment_intents/{intent}/verify_microdeposits", http.MethodPost, map[string]string{
-		"descriptor_code": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "create", "/v1/payment_links", http.MethodPost, map[string]string{
-		"allow_promotion_codes":      "boolean",
-		"application_fee_amount":     "integer",
-		"application_fee_percent":    "number",
-		"billing_address_collection": "string",
-		"currency":                   "string",
-		"customer_creation":          "string",
-		"on_behalf_of":               "string",
-		"payment_method_collection":  "string",
-		"submit_type":                "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "list", "/v1/payment_links", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "list_line_items", "/v1/payment_links/{payment_link}/line_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "retrieve", "/v1/payment_links/{payment_link}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "update", "/v1/payment_links/{payment_link}", http.MethodPost, map[string]string{
-		"active":                     "boolean",
-		"allow_promotion_codes":      "boolean",
-		"billing_address_collection": "string",
-		"customer_creation":          "string",
-		"payment_method_collection":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "attach", "/v1/payment_methods/{payment_method}/attach", http.MethodPost, map[string]string{
-		"customer": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "create", "/v1/payment_methods", http.MethodPost, map[string]string{
-		"customer":       "string",
-		"payment_method": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "detach", "/v1/payment_methods/{payment_method}/detach", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "list", "/v1/payment_methods", http.MethodGet, map[string]string{
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "retrieve", "/v1/payment_methods/{payment_method}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "update", "/v1/payment_methods/{payment_method}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "create", "/v1/customers/{customer}/sources", http.MethodPost, map[string]string{
-		"source":   "string",
-		"validate": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "list", "/v1/customers/{customer}/sources", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"object":         "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "retrieve", "/v1/customers/{customer}/sources/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "cancel", "/v1/payouts/{payout}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "create", "/v1/payouts", http.MethodPost, map[string]string{
-		"amount":               "integer",
-		"currency":             "string",
-		"description":          "string",
-		"destination":          "string",
-		"method":               "string",
-		"source_type":          "string",
-		"statement_descriptor": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "list", "/v1/payouts", http.MethodGet, map[string]string{
-		"arrival_date":   "integer",
-		"created":        "integer",
-		"destination":    "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "retrieve", "/v1/payouts/{payout}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "reverse", "/v1/payouts/{payout}/reverse", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPayoutsCmd.Cmd, "update", "/v1/payouts/{payout}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPersonsCmd.Cmd, "create", "/v1/accounts/{account}/persons", http.MethodPost, map[string]string{
-		"email":               "string",
-		"first_name":          "string",
-		"first_name_kana":     "string",
-		"first_name_kanji":    "string",
-		"gender":              "string",
-		"id_number":           "string",
-		"id_number_secondary": "string",
-		"last_name":           "string",
-		"last_name_kana":      "string",
-		"last_name_kanji":     "string",
-		"maiden_name":         "string",
-		"nationality":         "string",
-		"person_token":        "string",
-		"phone":               "string",
-		"political_exposure":  "string",
-		"ssn_last_4":          "string",
-	}, &Config)
-	resource.NewOperationCmd(rPersonsCmd.Cmd, "delete", "/v1/accounts/{account}/persons/{person}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPersonsCmd.Cmd, "list", "/v1/accounts/{account}/persons", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPersonsCmd.Cmd, "retrieve", "/v1/accounts/{account}/persons/{person}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPersonsCmd.Cmd, "update", "/v1/accounts/{account}/persons/{person}", http.MethodPost, map[string]string{
-		"email":               "string",
-		"first_name":          "string",
-		"first_name_kana":     "string",
-		"first_name_kanji":    "string",
-		"gender":              "string",
-		"id_number":           "string",
-		"id_number_secondary": "string",
-		"last_name":           "string",
-		"last_name_kana":      "string",
-		"last_name_kanji":     "string",
-		"maiden_name":         "string",
-		"nationality":         "string",
-		"person_token":        "string",
-		"phone":               "string",
-		"political_exposure":  "string",
-		"ssn_last_4":          "string",
-	}, &Config)
-	resource.NewOperationCmd(rPlansCmd.Cmd, "create", "/v1/plans", http.MethodPost, map[string]string{
-		"active":            "boolean",
-		"aggregate_usage":   "string",
-		"amount":            "integer",
-		"amount_decimal":    "string",
-		"billing_scheme":    "string",
-		"currency":          "string",
-		"id":                "string",
-		"interval":          "string",
-		"interval_count":    "integer",
-		"nickname":          "string",
-		"product":           "string",
-		"tiers_mode":        "string",
-		"trial_period_days": "integer",
-		"usage_type":        "string",
-	}, &Config)
-	resource.NewOperationCmd(rPlansCmd.Cmd, "delete", "/v1/plans/{plan}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPlansCmd.Cmd, "list", "/v1/plans", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"product":        "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPlansCmd.Cmd, "retrieve", "/v1/plans/{plan}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPlansCmd.Cmd, "update", "/v1/plans/{plan}", http.MethodPost, map[string]string{
-		"active":            "boolean",
-		"nickname":          "string",
-		"product":           "string",
-		"trial_period_days": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rPricesCmd.Cmd, "create", "/v1/prices", http.MethodPost, map[string]string{
-		"active":              "boolean",
-		"billing_scheme":      "string",
-		"currency":            "string",
-		"lookup_key":          "string",
-		"nickname":            "string",
-		"product":             "string",
-		"tax_behavior":        "string",
-		"tiers_mode":          "string",
-		"transfer_lookup_key": "boolean",
-		"unit_amount":         "integer",
-		"unit_amount_decimal": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPricesCmd.Cmd, "list", "/v1/prices", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"created":        "integer",
-		"currency":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"product":        "string",
-		"starting_after": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rPricesCmd.Cmd, "retrieve", "/v1/prices/{price}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPricesCmd.Cmd, "search", "/v1/prices/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPricesCmd.Cmd, "update", "/v1/prices/{price}", http.MethodPost, map[string]string{
-		"active":              "boolean",
-		"lookup_key":          "string",
-		"nickname":            "string",
-		"tax_behavior":        "string",
-		"transfer_lookup_key": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "create", "/v1/products", http.MethodPost, map[string]string{
-		"active":               "boolean",
-		"caption":              "string",
-		"description":          "string",
-		"id":                   "string",
-		"name":                 "string",
-		"shippable":            "boolean",
-		"statement_descriptor": "string",
-		"tax_code":             "string",
-		"type":                 "string",
-		"unit_label":           "string",
-		"url":                  "string",
-	}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "delete", "/v1/products/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "list", "/v1/products", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"shippable":      "boolean",
-		"starting_after": "string",
-		"type":           "string",
-		"url":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "retrieve", "/v1/products/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "search", "/v1/products/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rProductsCmd.Cmd, "update", "/v1/products/{id}", http.MethodPost, map[string]string{
-		"active":               "boolean",
-		"caption":              "string",
-		"default_price":        "string",
-		"description":          "string",
-		"name":                 "string",
-		"shippable":            "boolean",
-		"statement_descriptor": "string",
-		"tax_code":             "string",
-		"unit_label":           "string",
-		"url":                  "string",
-	}, &Config)
-	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "create", "/v1/promotion_codes", http.MethodPost, map[string]string{
-		"active":          "boolean",
-		"code":            "string",
-		"coupon":          "string",
-		"customer":        "string",
-		"expires_at":      "integer",
-		"max_redemptions": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "list", "/v1/promotion_codes", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"code":           "string",
-		"coupon":         "string",
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "retrieve", "/v1/promotion_codes/{promotion_code}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "update", "/v1/promotion_codes/{promotion_code}", http.MethodPost, map[string]string{
-		"active": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "accept", "/v1/quotes/{quote}/accept", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "cancel", "/v1/quotes/{quote}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "create", "/v1/quotes", http.MethodPost, map[string]string{
-		"application_fee_amount":  "integer",
-		"application_fee_percent": "number",
-		"collection_method":       "string",
-		"customer":                "string",
-		"description":             "string",
-		"expires_at":              "integer",
-		"footer":                  "string",
-		"header":                  "string",
-		"on_behalf_of":            "string",
-		"test_clock":              "string",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "finalize_quote", "/v1/quotes/{quote}/finalize", http.MethodPost, map[string]string{
-		"expires_at": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "list", "/v1/quotes", http.MethodGet, map[string]string{
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-		"test_clock":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "list_computed_upfront_line_items", "/v1/quotes/{quote}/computed_upfront_line_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "list_line_items", "/v1/quotes/{quote}/line_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "pdf", "/v1/quotes/{quote}/pdf", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "retrieve", "/v1/quotes/{quote}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rQuotesCmd.Cmd, "update", "/v1/quotes/{quote}", http.MethodPost, map[string]string{
-		"application_fee_amount":  "integer",
-		"application_fee_percent": "number",
-		"collection_method":       "string",
-		"customer":                "string",
-		"description":             "string",
-		"expires_at":              "integer",
-		"footer":                  "string",
-		"header":                  "string",
-		"on_behalf_of":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rRefundsCmd.Cmd, "cancel", "/v1/refunds/{refund}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRefundsCmd.Cmd, "create", "/v1/refunds", http.MethodPost, map[string]string{
-		"amount":                 "integer",
-		"charge":                 "string",
-		"currency":               "string",
-		"customer":               "string",
-		"instructions_email":     "string",
-		"origin":                 "string",
-		"payment_intent":         "string",
-		"reason":                 "string",
-		"refund_application_fee": "boolean",
-		"reverse_transfer":       "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rRefundsCmd.Cmd, "list", "/v1/refunds", http.MethodGet, map[string]string{
-		"charge":         "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_intent": "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rRefundsCmd.Cmd, "retrieve", "/v1/refunds/{refund}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRefundsCmd.Cmd, "update", "/v1/refunds/{refund}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRefundsTestHelpersCmd.Cmd, "expire", "/v1/test_helpers/refunds/{refund}/expire", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rReviewsCmd.Cmd, "approve", "/v1/reviews/{review}/approve", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rReviewsCmd.Cmd, "list", "/v1/reviews", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rReviewsCmd.Cmd, "retrieve", "/v1/reviews/{review}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rScheduledQueryRunsCmd.Cmd, "list", "/v1/sigma/scheduled_query_runs", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rScheduledQueryRunsCmd.Cmd, "retrieve", "/v1/sigma/scheduled_query_runs/{scheduled_query_run}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSetupAttemptsCmd.Cmd, "list", "/v1/setup_attempts", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"setup_intent":   "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "cancel", "/v1/setup_intents/{intent}/cancel", http.MethodPost, map[string]string{
-		"cancellation_reason": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "confirm", "/v1/setup_intents/{intent}/confirm", http.MethodPost, map[string]string{
-		"payment_method": "string",
-		"return_url":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "create", "/v1/setup_intents", http.MethodPost, map[string]string{
-		"attach_to_self": "boolean",
-		"confirm":        "boolean",
-		"customer":       "string",
-		"description":    "string",
-		"on_behalf_of":   "string",
-		"payment_method": "string",
-		"return_url":     "string",
-		"usage":          "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "list", "/v1/setup_intents", http.MethodGet, map[string]string{
-		"attach_to_self": "boolean",
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_method": "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "retrieve", "/v1/setup_intents/{intent}", http.MethodGet, map[string]string{
-		"client_secret": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "update", "/v1/setup_intents/{intent}", http.MethodPost, map[string]string{
-		"attach_to_self": "boolean",
-		"customer":       "string",
-		"description":    "string",
-		"payment_method": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "verify_microdeposits", "/v1/setup_intents/{intent}/verify_microdeposits", http.MethodPost, map[string]string{
-		"descriptor_code": "string",
-	}, &Config)
-	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "create", "/v1/shipping_rates", http.MethodPost, map[string]string{
-		"display_name": "string",
-		"tax_behavior": "string",
-		"tax_code":     "string",
-		"type":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "list", "/v1/shipping_rates", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"created":        "integer",
-		"currency":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "retrieve", "/v1/shipping_rates/{shipping_rate_token}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "update", "/v1/shipping_rates/{shipping_rate_token}", http.MethodPost, map[string]string{
-		"active":       "boolean",
-		"tax_behavior": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSkusCmd.Cmd, "create", "/v1/skus", http.MethodPost, map[string]string{
-		"active":   "boolean",
-		"currency": "string",
-		"id":       "string",
-		"image":    "string",
-		"price":    "integer",
-		"product":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rSkusCmd.Cmd, "delete", "/v1/skus/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSkusCmd.Cmd, "list", "/v1/skus", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"ending_before":  "string",
-		"in_stock":       "boolean",
-		"limit":          "integer",
-		"product":        "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSkusCmd.Cmd, "retrieve", "/v1/skus/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSkusCmd.Cmd, "update", "/v1/skus/{id}", http.MethodPost, map[string]string{
-		"active":   "boolean",
-		"currency": "string",
-		"image":    "string",
-		"price":    "integer",
-		"product":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "create", "/v1/sources", http.MethodPost, map[string]string{
-		"amount":               "integer",
-		"currency":             "string",
-		"customer":             "string",
-		"flow":                 "string",
-		"original_source":      "string",
-		"statement_descriptor": "string",
-		"token":                "string",
-		"type":                 "string",
-		"usage":                "string",
-	}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "detach", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "retrieve", "/v1/sources/{source}", http.MethodGet, map[string]string{
-		"client_secret": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "source_transactions", "/v1/sources/{source}/source_transactions", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "update", "/v1/sources/{source}", http.MethodPost, map[string]string{
-		"amount": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rSourcesCmd.Cmd, "verify", "/v1/sources/{source}/verify", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "create", "/v1/subscription_items", http.MethodPost, map[string]string{
-		"payment_behavior":   "string",
-		"plan":               "string",
-		"price":              "string",
-		"proration_behavior": "string",
-		"proration_date":     "integer",
-		"quantity":           "integer",
-		"subscription":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "delete", "/v1/subscription_items/{item}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "list", "/v1/subscription_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"subscription":   "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "retrieve", "/v1/subscription_items/{item}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "update", "/v1/subscription_items/{item}", http.MethodPost, map[string]string{
-		"off_session":        "boolean",
-		"payment_behavior":   "string",
-		"plan":               "string",
-		"price":              "string",
-		"proration_behavior": "string",
-		"proration_date":     "integer",
-		"quantity":           "integer",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "usage_record_summaries", "/v1/subscription_items/{subscription_item}/usage_record_summaries", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "cancel", "/v1/subscription_schedules/{schedule}/cancel", http.MethodPost, map[string]string{
-		"invoice_now": "boolean",
-		"prorate":     "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "create", "/v1/subscription_schedules", http.MethodPost, map[string]string{
-		"customer":          "string",
-		"end_behavior":      "string",
-		"from_subscription": "string",
-		"start_date":        "integer",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "list", "/v1/subscription_schedules", http.MethodGet, map[string]string{
-		"canceled_at":    "integer",
-		"completed_at":   "integer",
-		"created":        "integer",
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"released_at":    "integer",
-		"scheduled":      "boolean",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "release", "/v1/subscription_schedules/{schedule}/release", http.MethodPost, map[string]string{
-		"preserve_cancel_date": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "retrieve", "/v1/subscription_schedules/{schedule}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "update", "/v1/subscription_schedules/{schedule}", http.MethodPost, map[string]string{
-		"end_behavior":       "string",
-		"proration_behavior": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "cancel", "/v1/subscriptions/{subscription_exposed_id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "create", "/v1/subscriptions", http.MethodPost, map[string]string{
-		"application_fee_percent": "number",
-		"backdate_start_date":     "integer",
-		"billing_cycle_anchor":    "integer",
-		"cancel_at":               "integer",
-		"cancel_at_period_end":    "boolean",
-		"collection_method":       "string",
-		"coupon":                  "string",
-		"currency":                "string",
-		"customer":                "string",
-		"days_until_due":          "integer",
-		"default_payment_method":  "string",
-		"default_source":          "string",
-		"description":             "string",
-		"off_session":             "boolean",
-		"payment_behavior":        "string",
-		"promotion_code":          "string",
-		"proration_behavior":      "string",
-		"trial_end":               "string",
-		"trial_from_plan":         "boolean",
-		"trial_period_days":       "integer",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "delete_discount", "/v1/subscriptions/{subscription_exposed_id}/discount", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "list", "/v1/subscriptions", http.MethodGet, map[string]string{
-		"collection_method":    "string",
-		"created":              "integer",
-		"current_period_end":   "integer",
-		"current_period_start": "integer",
-		"customer":             "string",
-		"ending_before":        "string",
-		"limit":                "integer",
-		"plan":                 "string",
-		"price":                "string",
-		"starting_after":       "string",
-		"status":               "string",
-		"test_clock":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "retrieve", "/v1/subscriptions/{subscription_exposed_id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "search", "/v1/subscriptions/search", http.MethodGet, map[string]string{
-		"limit": "integer",
-		"page":  "string",
-		"query": "string",
-	}, &Config)
-	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "update", "/v1/subscriptions/{subscription_exposed_id}", http.MethodPost, map[string]string{
-		"application_fee_percent": "number",
-		"billing_cycle_anchor":    "string",
-		"cancel_at":               "integer",
-		"cancel_at_period_end":    "boolean",
-		"collection_method":       "string",
-		"coupon":                  "string",
-		"days_until_due":          "integer",
-		"default_payment_method":  "string",
-		"default_source":          "string",
-		"description":             "string",
-		"off_session":             "boolean",
-		"payment_behavior":        "string",
-		"promotion_code":          "string",
-		"proration_behavior":      "string",
-		"proration_date":          "integer",
-		"trial_end":               "string",
-		"trial_from_plan":         "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rTaxCodesCmd.Cmd, "list", "/v1/tax_codes", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTaxCodesCmd.Cmd, "retrieve", "/v1/tax_codes/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "create", "/v1/customers/{customer}/tax_ids", http.MethodPost, map[string]string{
-		"type":  "string",
-		"value": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "delete", "/v1/customers/{customer}/tax_ids/{id}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "list", "/v1/customers/{customer}/tax_ids", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "retrieve", "/v1/customers/{customer}/tax_ids/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "create", "/v1/tax_rates", http.MethodPost, map[string]string{
-		"active":       "boolean",
-		"country":      "string",
-		"description":  "string",
-		"display_name": "string",
-		"inclusive":    "boolean",
-		"jurisdiction": "string",
-		"percentage":   "number",
-		"state":        "string",
-		"tax_type":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "list", "/v1/tax_rates", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"created":        "integer",
-		"ending_before":  "string",
-		"inclusive":      "boolean",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "retrieve", "/v1/tax_rates/{tax_rate}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "update", "/v1/tax_rates/{tax_rate}", http.MethodPost, map[string]string{
-		"active":       "boolean",
-		"country":      "string",
-		"description":  "string",
-		"display_name": "string",
-		"jurisdiction": "string",
-		"state":        "string",
-		"tax_type":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rTokensCmd.Cmd, "create", "/v1/tokens", http.MethodPost, map[string]string{
-		"card":     "string",
-		"customer": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTokensCmd.Cmd, "retrieve", "/v1/tokens/{token}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTopupsCmd.Cmd, "cancel", "/v1/topups/{topup}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTopupsCmd.Cmd, "create", "/v1/topups", http.MethodPost, map[string]string{
-		"amount":               "integer",
-		"currency":             "string",
-		"description":          "string",
-		"source":               "string",
-		"statement_descriptor": "string",
-		"transfer_group":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rTopupsCmd.Cmd, "list", "/v1/topups", http.MethodGet, map[string]string{
-		"amount":         "integer",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rTopupsCmd.Cmd, "retrieve", "/v1/topups/{topup}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTopupsCmd.Cmd, "update", "/v1/topups/{topup}", http.MethodPost, map[string]string{
-		"description": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "create", "/v1/transfers/{id}/reversals", http.MethodPost, map[string]string{
-		"amount":                 "integer",
-		"description":            "string",
-		"refund_application_fee": "boolean",
-	}, &Config)
-	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "list", "/v1/transfers/{id}/reversals", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "retrieve", "/v1/transfers/{transfer}/reversals/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "update", "/v1/transfers/{transfer}/reversals/{id}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTransfersCmd.Cmd, "create", "/v1/transfers", http.MethodPost, map[string]string{
-		"amount":             "integer",
-		"currency":           "string",
-		"description":        "string",
-		"destination":        "string",
-		"source_transaction": "string",
-		"source_type":        "string",
-		"transfer_group":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rTransfersCmd.Cmd, "list", "/v1/transfers", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"destination":    "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"transfer_group": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTransfersCmd.Cmd, "retrieve", "/v1/transfers/{transfer}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTransfersCmd.Cmd, "update", "/v1/transfers/{transfer}", http.MethodPost, map[string]string{
-		"description": "string",
-	}, &Config)
-	resource.NewOperationCmd(rUsageRecordSummariesCmd.Cmd, "list", "/v1/subscription_items/{subscription_item}/usage_record_summaries", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rUsageRecordsCmd.Cmd, "create", "/v1/subscription_items/{subscription_item}/usage_records", http.MethodPost, map[string]string{
-		"action":    "string",
-		"quantity":  "integer",
-		"timestamp": "string",
-	}, &Config)
-	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "create", "/v1/webhook_endpoints", http.MethodPost, map[string]string{
-		"api_version": "string",
-		"connect":     "boolean",
-		"description": "string",
-		"url":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "delete", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "list", "/v1/webhook_endpoints", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "retrieve", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "update", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodPost, map[string]string{
-		"description": "string",
-		"disabled":    "boolean",
-		"url":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "create", "/v1/apps/secrets", http.MethodPost, map[string]string{
-		"expires_at": "integer",
-		"name":       "string",
-		"payload":    "string",
-	}, &Config)
-	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "delete_where", "/v1/apps/secrets/delete", http.MethodPost, map[string]string{
-		"name": "string",
-	}, &Config)
-	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "find", "/v1/apps/secrets/find", http.MethodGet, map[string]string{
-		"name": "string",
-	}, &Config)
-	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "list", "/v1/apps/secrets", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "create", "/v1/billing_portal/configurations", http.MethodPost, map[string]string{
-		"default_return_url": "string",
-	}, &Config)
-	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "list", "/v1/billing_portal/configurations", http.MethodGet, map[string]string{
-		"active":         "boolean",
-		"ending_before":  "string",
-		"is_default":     "boolean",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "retrieve", "/v1/billing_portal/configurations/{configuration}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "update", "/v1/billing_portal/configurations/{configuration}", http.MethodPost, map[string]string{
-		"active":             "boolean",
-		"default_return_url": "string",
-	}, &Config)
-	resource.NewOperationCmd(rBillingPortalSessionsCmd.Cmd, "create", "/v1/billing_portal/sessions", http.MethodPost, map[string]string{
-		"configuration": "string",
-		"customer":      "string",
-		"locale":        "string",
-		"on_behalf_of":  "string",
-		"return_url":    "string",
-	}, &Config)
-	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "create", "/v1/checkout/sessions", http.MethodPost, map[string]string{
-		"allow_promotion_codes":      "boolean",
-		"billing_address_collection": "string",
-		"cancel_url":                 "string",
-		"client_reference_id":        "string",
-		"currency":                   "string",
-		"customer":                   "string",
-		"customer_creation":          "string",
-		"customer_email":             "string",
-		"expires_at":                 "integer",
-		"locale":                     "string",
-		"mode":                       "string",
-		"payment_method_collection":  "string",
-		"submit_type":                "string",
-		"success_url":                "string",
-	}, &Config)
-	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "expire", "/v1/checkout/sessions/{session}/expire", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "list", "/v1/checkout/sessions", http.MethodGet, map[string]string{
-		"customer":       "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_intent": "string",
-		"starting_after": "string",
-		"subscription":   "string",
-	}, &Config)
-	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "list_line_items", "/v1/checkout/sessions/{session}/line_items", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "retrieve", "/v1/checkout/sessions/{session}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "disconnect", "/v1/financial_connections/accounts/{account}/disconnect", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "list", "/v1/financial_connections/accounts", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"session":        "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "list_owners", "/v1/financial_connections/accounts/{account}/owners", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"ownership":      "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "refresh", "/v1/financial_connections/accounts/{account}/refresh", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "retrieve", "/v1/financial_connections/accounts/{account}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsSessionsCmd.Cmd, "create", "/v1/financial_connections/sessions", http.MethodPost, map[string]string{
-		"return_url": "string",
-	}, &Config)
-	resource.NewOperationCmd(rFinancialConnectionsSessionsCmd.Cmd, "retrieve", "/v1/financial_connections/sessions/{session}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationReportsCmd.Cmd, "list", "/v1/identity/verification_reports", http.MethodGet, map[string]string{
-		"created":              "integer",
-		"ending_before":        "string",
-		"limit":                "integer",
-		"starting_after":       "string",
-		"type":                 "string",
-		"verification_session": "string",
-	}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationReportsCmd.Cmd, "retrieve", "/v1/identity/verification_reports/{report}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "cancel", "/v1/identity/verification_sessions/{session}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "create", "/v1/identity/verification_sessions", http.MethodPost, map[string]string{
-		"return_url": "string",
-		"type":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "list", "/v1/identity/verification_sessions", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "redact", "/v1/identity/verification_sessions/{session}/redact", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "retrieve", "/v1/identity/verification_sessions/{session}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "update", "/v1/identity/verification_sessions/{session}", http.MethodPost, map[string]string{
-		"type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "approve", "/v1/issuing/authorizations/{authorization}/approve", http.MethodPost, map[string]string{
-		"amount": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "decline", "/v1/issuing/authorizations/{authorization}/decline", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "list", "/v1/issuing/authorizations", http.MethodGet, map[string]string{
-		"card":           "string",
-		"cardholder":     "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "retrieve", "/v1/issuing/authorizations/{authorization}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "update", "/v1/issuing/authorizations/{authorization}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "create", "/v1/issuing/cardholders", http.MethodPost, map[string]string{
-		"email":        "string",
-		"name":         "string",
-		"phone_number": "string",
-		"status":       "string",
-		"type":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "list", "/v1/issuing/cardholders", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"email":          "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"phone_number":   "string",
-		"starting_after": "string",
-		"status":         "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "retrieve", "/v1/issuing/cardholders/{cardholder}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "update", "/v1/issuing/cardholders/{cardholder}", http.MethodPost, map[string]string{
-		"email":        "string",
-		"phone_number": "string",
-		"status":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "create", "/v1/issuing/cards", http.MethodPost, map[string]string{
-		"cardholder":         "string",
-		"currency":           "string",
-		"financial_account":  "string",
-		"replacement_for":    "string",
-		"replacement_reason": "string",
-		"status":             "string",
-		"type":               "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "list", "/v1/issuing/cards", http.MethodGet, map[string]string{
-		"cardholder":     "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"exp_month":      "integer",
-		"exp_year":       "integer",
-		"last4":          "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "retrieve", "/v1/issuing/cards/{card}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "update", "/v1/issuing/cards/{card}", http.MethodPost, map[string]string{
-		"cancellation_reason": "string",
-		"status":              "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "deliver_card", "/v1/test_helpers/issuing/cards/{card}/shipping/deliver", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "fail_card", "/v1/test_helpers/issuing/cards/{card}/shipping/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "return_card", "/v1/test_helpers/issuing/cards/{card}/shipping/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "ship_card", "/v1/test_helpers/issuing/cards/{card}/shipping/ship", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "create", "/v1/issuing/disputes", http.MethodPost, map[string]string{
-		"amount":      "integer",
-		"transaction": "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "list", "/v1/issuing/disputes", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"status":         "string",
-		"transaction":    "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "retrieve", "/v1/issuing/disputes/{dispute}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "submit", "/v1/issuing/disputes/{dispute}/submit", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "update", "/v1/issuing/disputes/{dispute}", http.MethodPost, map[string]string{
-		"amount": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "list", "/v1/issuing/transactions", http.MethodGet, map[string]string{
-		"card":           "string",
-		"cardholder":     "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"type":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "retrieve", "/v1/issuing/transactions/{transaction}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "update", "/v1/issuing/transactions/{transaction}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarEarlyFraudWarningsCmd.Cmd, "list", "/v1/radar/early_fraud_warnings", http.MethodGet, map[string]string{
-		"charge":         "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"payment_intent": "string",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rRadarEarlyFraudWarningsCmd.Cmd, "retrieve", "/v1/radar/early_fraud_warnings/{early_fraud_warning}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "create", "/v1/radar/value_list_items", http.MethodPost, map[string]string{
-		"value":      "string",
-		"value_list": "string",
-	}, &Config)
-	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "delete", "/v1/radar/value_list_items/{item}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "list", "/v1/radar/value_list_items", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-		"value":          "string",
-		"value_list":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "retrieve", "/v1/radar/value_list_items/{item}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "create", "/v1/radar/value_lists", http.MethodPost, map[string]string{
-		"alias":     "string",
-		"item_type": "string",
-		"name":      "string",
-	}, &Config)
-	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "delete", "/v1/radar/value_lists/{value_list}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "list", "/v1/radar/value_lists", http.MethodGet, map[string]string{
-		"alias":          "string",
-		"contains":       "string",
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "retrieve", "/v1/radar/value_lists/{value_list}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "update", "/v1/radar/value_lists/{value_list}", http.MethodPost, map[string]string{
-		"alias": "string",
-		"name":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "create", "/v1/reporting/report_runs", http.MethodPost, map[string]string{
-		"report_type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "list", "/v1/reporting/report_runs", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "retrieve", "/v1/reporting/report_runs/{report_run}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rReportingReportTypesCmd.Cmd, "list", "/v1/reporting/report_types", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rReportingReportTypesCmd.Cmd, "retrieve", "/v1/reporting/report_types/{report_type}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "create", "/v1/terminal/configurations", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "delete", "/v1/terminal/configurations/{configuration}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "list", "/v1/terminal/configurations", http.MethodGet, map[string]string{
-		"ending_before":      "string",
-		"is_account_default": "boolean",
-		"limit":              "integer",
-		"starting_after":     "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "retrieve", "/v1/terminal/configurations/{configuration}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "update", "/v1/terminal/configurations/{configuration}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalConnectionTokensCmd.Cmd, "create", "/v1/terminal/connection_tokens", http.MethodPost, map[string]string{
-		"location": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "create", "/v1/terminal/locations", http.MethodPost, map[string]string{
-		"configuration_overrides": "string",
-		"display_name":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "delete", "/v1/terminal/locations/{location}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "list", "/v1/terminal/locations", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "retrieve", "/v1/terminal/locations/{location}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "update", "/v1/terminal/locations/{location}", http.MethodPost, map[string]string{
-		"configuration_overrides": "string",
-		"display_name":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "cancel_action", "/v1/terminal/readers/{reader}/cancel_action", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "create", "/v1/terminal/readers", http.MethodPost, map[string]string{
-		"label":             "string",
-		"location":          "string",
-		"registration_code": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "delete", "/v1/terminal/readers/{reader}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "list", "/v1/terminal/readers", http.MethodGet, map[string]string{
-		"device_type":    "string",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"location":       "string",
-		"starting_after": "string",
-		"status":         "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "process_payment_intent", "/v1/terminal/readers/{reader}/process_payment_intent", http.MethodPost, map[string]string{
-		"payment_intent": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "process_setup_intent", "/v1/terminal/readers/{reader}/process_setup_intent", http.MethodPost, map[string]string{
-		"customer_consent_collected": "boolean",
-		"setup_intent":               "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "retrieve", "/v1/terminal/readers/{reader}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "set_reader_display", "/v1/terminal/readers/{reader}/set_reader_display", http.MethodPost, map[string]string{
-		"type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "update", "/v1/terminal/readers/{reader}", http.MethodPost, map[string]string{
-		"label": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTerminalReadersTestHelpersCmd.Cmd, "present_payment_method", "/v1/test_helpers/terminal/readers/{reader}/present_payment_method", http.MethodPost, map[string]string{
-		"type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersCustomersCmd.Cmd, "fund_cash_balance", "/v1/test_helpers/customers/{customer}/fund_cash_balance", http.MethodPost, map[string]string{
-		"amount":    "integer",
-		"currency":  "string",
-		"reference": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "deliver_card", "/v1/test_helpers/issuing/cards/{card}/shipping/deliver", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "fail_card", "/v1/test_helpers/issuing/cards/{card}/shipping/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "return_card", "/v1/test_helpers/issuing/cards/{card}/shipping/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "ship_card", "/v1/test_helpers/issuing/cards/{card}/shipping/ship", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersRefundsCmd.Cmd, "expire", "/v1/test_helpers/refunds/{refund}/expire", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTerminalReadersCmd.Cmd, "present_payment_method", "/v1/test_helpers/terminal/readers/{reader}/present_payment_method", http.MethodPost, map[string]string{
-		"type": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "advance", "/v1/test_helpers/test_clocks/{test_clock}/advance", http.MethodPost, map[string]string{
-		"frozen_time": "integer",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "create", "/v1/test_helpers/test_clocks", http.MethodPost, map[string]string{
-		"frozen_time": "integer",
-		"name":        "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "delete", "/v1/test_helpers/test_clocks/{test_clock}", http.MethodDelete, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "list", "/v1/test_helpers/test_clocks", http.MethodGet, map[string]string{
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "retrieve", "/v1/test_helpers/test_clocks/{test_clock}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "fail", "/v1/test_helpers/treasury/inbound_transfers/{id}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "return_inbound_transfer", "/v1/test_helpers/treasury/inbound_transfers/{id}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "succeed", "/v1/test_helpers/treasury/inbound_transfers/{id}/succeed", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_payments/{id}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_payments/{id}/post", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "return_outbound_payment", "/v1/test_helpers/treasury/outbound_payments/{id}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/post", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "return_outbound_transfer", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryReceivedCreditsCmd.Cmd, "create", "/v1/test_helpers/treasury/received_credits", http.MethodPost, map[string]string{
-		"amount":            "integer",
-		"currency":          "string",
-		"description":       "string",
-		"financial_account": "string",
-		"network":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rTestHelpersTreasuryReceivedDebitsCmd.Cmd, "create", "/v1/test_helpers/treasury/received_debits", http.MethodPost, map[string]string{
-		"amount":            "integer",
-		"currency":          "string",
-		"description":       "string",
-		"financial_account": "string",
-		"network":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "create", "/v1/treasury/credit_reversals", http.MethodPost, map[string]string{
-		"received_credit": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "list", "/v1/treasury/credit_reversals", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"received_credit":   "string",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "retrieve", "/v1/treasury/credit_reversals/{credit_reversal}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "create", "/v1/treasury/debit_reversals", http.MethodPost, map[string]string{
-		"received_debit": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "list", "/v1/treasury/debit_reversals", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"received_debit":    "string",
-		"resolution":        "string",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "retrieve", "/v1/treasury/debit_reversals/{debit_reversal}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "create", "/v1/treasury/financial_accounts", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "list", "/v1/treasury/financial_accounts", http.MethodGet, map[string]string{
-		"created":        "integer",
-		"ending_before":  "string",
-		"limit":          "integer",
-		"starting_after": "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "retrieve", "/v1/treasury/financial_accounts/{financial_account}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "retrieve_features", "/v1/treasury/financial_accounts/{financial_account}/features", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "update", "/v1/treasury/financial_accounts/{financial_account}", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "update_features", "/v1/treasury/financial_accounts/{financial_account}/features", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "cancel", "/v1/treasury/inbound_transfers/{inbound_transfer}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "create", "/v1/treasury/inbound_transfers", http.MethodPost, map[string]string{
-		"amount":                "integer",
-		"currency":              "string",
-		"description":           "string",
-		"financial_account":     "string",
-		"origin_payment_method": "string",
-		"statement_descriptor":  "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "list", "/v1/treasury/inbound_transfers", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "retrieve", "/v1/treasury/inbound_transfers/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/inbound_transfers/{id}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "return_inbound_transfer", "/v1/test_helpers/treasury/inbound_transfers/{id}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "succeed", "/v1/test_helpers/treasury/inbound_transfers/{id}/succeed", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "cancel", "/v1/treasury/outbound_payments/{id}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "create", "/v1/treasury/outbound_payments", http.MethodPost, map[string]string{
-		"amount":                     "integer",
-		"currency":                   "string",
-		"customer":                   "string",
-		"description":                "string",
-		"destination_payment_method": "string",
-		"financial_account":          "string",
-		"statement_descriptor":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "list", "/v1/treasury/outbound_payments", http.MethodGet, map[string]string{
-		"customer":          "string",
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "retrieve", "/v1/treasury/outbound_payments/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_payments/{id}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_payments/{id}/post", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "return_outbound_payment", "/v1/test_helpers/treasury/outbound_payments/{id}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "cancel", "/v1/treasury/outbound_transfers/{outbound_transfer}/cancel", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "create", "/v1/treasury/outbound_transfers", http.MethodPost, map[string]string{
-		"amount":                     "integer",
-		"currency":                   "string",
-		"description":                "string",
-		"destination_payment_method": "string",
-		"financial_account":          "string",
-		"statement_descriptor":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "list", "/v1/treasury/outbound_transfers", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "retrieve", "/v1/treasury/outbound_transfers/{outbound_transfer}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/fail", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/post", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "return_outbound_transfer", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/return", http.MethodPost, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedCreditsCmd.Cmd, "list", "/v1/treasury/received_credits", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedCreditsCmd.Cmd, "retrieve", "/v1/treasury/received_credits/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedCreditsTestHelpersCmd.Cmd, "create", "/v1/test_helpers/treasury/received_credits", http.MethodPost, map[string]string{
-		"amount":            "integer",
-		"currency":          "string",
-		"description":       "string",
-		"financial_account": "string",
-		"network":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedDebitsCmd.Cmd, "list", "/v1/treasury/received_debits", http.MethodGet, map[string]string{
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedDebitsCmd.Cmd, "retrieve", "/v1/treasury/received_debits/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryReceivedDebitsTestHelpersCmd.Cmd, "create", "/v1/test_helpers/treasury/received_debits", http.MethodPost, map[string]string{
-		"amount":            "integer",
-		"currency":          "string",
-		"description":       "string",
-		"financial_account": "string",
-		"network":           "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryTransactionEntrysCmd.Cmd, "list", "/v1/treasury/transaction_entries", http.MethodGet, map[string]string{
-		"created":           "integer",
-		"effective_at":      "integer",
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"order_by":          "string",
-		"starting_after":    "string",
-		"transaction":       "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryTransactionEntrysCmd.Cmd, "retrieve", "/v1/treasury/transaction_entries/{id}", http.MethodGet, map[string]string{}, &Config)
-	resource.NewOperationCmd(rTreasuryTransactionsCmd.Cmd, "list", "/v1/treasury/transactions", http.MethodGet, map[string]string{
-		"created":           "integer",
-		"ending_before":     "string",
-		"financial_account": "string",
-		"limit":             "integer",
-		"order_by":          "string",
-		"starting_after":    "string",
-		"status":            "string",
-	}, &Config)
-	resource.NewOperationCmd(rTreasuryTransactionsCmd.Cmd, "retrieve", "/v1/treasury/transactions/{id}", http.MethodGet, map[string]string{}, &Config)
+	resource.NewOperationCmd(rAccountLinksCmd.Cmd, "create", "/v1/account_links", http.MethodPost, map[string]resource.Flag{
+		"account":     {Type: "string"},
+		"collect":     {Type: "string"},
+		"refresh_url": {Type: "string"},
+		"return_url":  {Type: "string"},
+		"type":        {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "capabilities", "/v1/accounts/{account}/capabilities", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "create", "/v1/accounts", http.MethodPost, map[string]resource.Flag{
+		"account_token":    {Type: "string"},
+		"business_type":    {Type: "string"},
+		"country":          {Type: "string"},
+		"default_currency": {Type: "string"},
+		"email":            {Type: "string"},
+		"external_account": {Type: "string"},
+		"type":             {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "delete", "/v1/accounts/{account}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "list", "/v1/accounts", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "persons", "/v1/accounts/{account}/persons", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "reject", "/v1/accounts/{account}/reject", http.MethodPost, map[string]resource.Flag{
+		"reason": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "retrieve", "/v1/account", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rAccountsCmd.Cmd, "update", "/v1/accounts/{account}", http.MethodPost, map[string]resource.Flag{
+		"account_token":    {Type: "string"},
+		"business_type":    {Type: "string"},
+		"default_currency": {Type: "string"},
+		"email":            {Type: "string"},
+		"external_account": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "create", "/v1/apple_pay/domains", http.MethodPost, map[string]resource.Flag{
+		"domain_name": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "delete", "/v1/apple_pay/domains/{domain}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "list", "/v1/apple_pay/domains", http.MethodGet, map[string]resource.Flag{
+		"domain_name":    {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rApplePayDomainsCmd.Cmd, "retrieve", "/v1/apple_pay/domains/{domain}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rApplicationFeesCmd.Cmd, "list", "/v1/application_fees", http.MethodGet, map[string]resource.Flag{
+		"charge":         {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rApplicationFeesCmd.Cmd, "retrieve", "/v1/application_fees/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rBalanceCmd.Cmd, "retrieve", "/v1/balance", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rBalanceTransactionsCmd.Cmd, "list", "/v1/balance_transactions", http.MethodGet, map[string]resource.Flag{
+		"available_on":   {Type: "integer"},
+		"created":        {Type: "integer"},
+		"currency":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payout":         {Type: "string"},
+		"source":         {Type: "string"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBalanceTransactionsCmd.Cmd, "retrieve", "/v1/balance_transactions/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "delete", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "update", "/v1/customers/{customer}/sources/{id}", http.MethodPost, map[string]resource.Flag{
+		"account_holder_name": {Type: "string"},
+		"account_holder_type": {Type: "string"},
+		"address_city":        {Type: "string"},
+		"address_country":     {Type: "string"},
+		"address_line1":       {Type: "string"},
+		"address_line2":       {Type: "string"},
+		"address_state":       {Type: "string"},
+		"address_zip":         {Type: "string"},
+		"exp_month":           {Type: "string"},
+		"exp_year":            {Type: "string"},
+		"name":                {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBankAccountsCmd.Cmd, "verify", "/v1/customers/{customer}/sources/{id}/verify", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "list", "/v1/accounts/{account}/capabilities", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "retrieve", "/v1/accounts/{account}/capabilities/{capability}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCapabilitiesCmd.Cmd, "update", "/v1/accounts/{account}/capabilities/{capability}", http.MethodPost, map[string]resource.Flag{
+		"requested": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rCardsCmd.Cmd, "delete", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCardsCmd.Cmd, "update", "/v1/customers/{customer}/sources/{id}", http.MethodPost, map[string]resource.Flag{
+		"account_holder_name": {Type: "string"},
+		"account_holder_type": {Type: "string"},
+		"address_city":        {Type: "string"},
+		"address_country":     {Type: "string"},
+		"address_line1":       {Type: "string"},
+		"address_line2":       {Type: "string"},
+		"address_state":       {Type: "string"},
+		"address_zip":         {Type: "string"},
+		"exp_month":           {Type: "string"},
+		"exp_year":            {Type: "string"},
+		"name":                {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCashBalancesCmd.Cmd, "retrieve", "/v1/customers/{customer}/cash_balance", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCashBalancesCmd.Cmd, "update", "/v1/customers/{customer}/cash_balance", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "capture", "/v1/charges/{charge}/capture", http.MethodPost, map[string]resource.Flag{
+		"amount":                      {Type: "integer"},
+		"application_fee":             {Type: "integer"},
+		"application_fee_amount":      {Type: "integer"},
+		"receipt_email":               {Type: "string"},
+		"statement_descriptor":        {Type: "string"},
+		"statement_descriptor_suffix": {Type: "string"},
+		"transfer_group":              {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "create", "/v1/charges", http.MethodPost, map[string]resource.Flag{
+		"amount":                      {Type: "integer"},
+		"application_fee":             {Type: "integer"},
+		"application_fee_amount":      {Type: "integer"},
+		"capture":                     {Type: "boolean"},
+		"currency":                    {Type: "string"},
+		"customer":                    {Type: "string"},
+		"description":                 {Type: "string"},
+		"on_behalf_of":                {Type: "string"},
+		"receipt_email":               {Type: "string"},
+		"source":                      {Type: "string"},
+		"statement_descriptor":        {Type: "string"},
+		"statement_descriptor_suffix": {Type: "string"},
+		"transfer_group":              {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "list", "/v1/charges", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_intent": {Type: "string"},
+		"starting_after": {Type: "string"},
+		"transfer_group": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "retrieve", "/v1/charges/{charge}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "search", "/v1/charges/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rChargesCmd.Cmd, "update", "/v1/charges/{charge}", http.MethodPost, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"description":    {Type: "string"},
+		"receipt_email":  {Type: "string"},
+		"transfer_group": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCountrySpecsCmd.Cmd, "list", "/v1/country_specs", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCountrySpecsCmd.Cmd, "retrieve", "/v1/country_specs/{country}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCouponsCmd.Cmd, "create", "/v1/coupons", http.MethodPost, map[string]resource.Flag{
+		"amount_off":         {Type: "integer"},
+		"currency":           {Type: "string"},
+		"duration":           {Type: "string"},
+		"duration_in_months": {Type: "integer"},
+		"id":                 {Type: "string"},
+		"max_redemptions":    {Type: "integer"},
+		"name":               {Type: "string"},
+		"percent_off":        {Type: "number"},
+		"redeem_by":          {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rCouponsCmd.Cmd, "delete", "/v1/coupons/{coupon}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCouponsCmd.Cmd, "list", "/v1/coupons", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCouponsCmd.Cmd, "retrieve", "/v1/coupons/{coupon}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCouponsCmd.Cmd, "update", "/v1/coupons/{coupon}", http.MethodPost, map[string]resource.Flag{
+		"name": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNoteLineItemsCmd.Cmd, "list", "/v1/credit_notes/{credit_note}/lines", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "create", "/v1/credit_notes", http.MethodPost, map[string]resource.Flag{
+		"amount":             {Type: "integer"},
+		"credit_amount":      {Type: "integer"},
+		"invoice":            {Type: "string"},
+		"memo":               {Type: "string"},
+		"out_of_band_amount": {Type: "integer"},
+		"reason":             {Type: "string"},
+		"refund":             {Type: "string"},
+		"refund_amount":      {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "list", "/v1/credit_notes", http.MethodGet, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"invoice":        {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "preview", "/v1/credit_notes/preview", http.MethodGet, map[string]resource.Flag{
+		"amount":             {Type: "integer"},
+		"credit_amount":      {Type: "integer"},
+		"invoice":            {Type: "string"},
+		"memo":               {Type: "string"},
+		"out_of_band_amount": {Type: "integer"},
+		"reason":             {Type: "string"},
+		"refund":             {Type: "string"},
+		"refund_amount":      {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "preview_lines", "/v1/credit_notes/preview/lines", http.MethodGet, map[string]resource.Flag{
+		"amount":             {Type: "integer"},
+		"credit_amount":      {Type: "integer"},
+		"ending_before":      {Type: "string"},
+		"invoice":            {Type: "string"},
+		"limit":              {Type: "integer"},
+		"memo":               {Type: "string"},
+		"out_of_band_amount": {Type: "integer"},
+		"reason":             {Type: "string"},
+		"refund":             {Type: "string"},
+		"refund_amount":      {Type: "integer"},
+		"starting_after":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "retrieve", "/v1/credit_notes/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "update", "/v1/credit_notes/{id}", http.MethodPost, map[string]resource.Flag{
+		"memo": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCreditNotesCmd.Cmd, "void_credit_note", "/v1/credit_notes/{id}/void", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "create", "/v1/customers/{customer}/balance_transactions", http.MethodPost, map[string]resource.Flag{
+		"amount":      {Type: "integer"},
+		"currency":    {Type: "string"},
+		"description": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "list", "/v1/customers/{customer}/balance_transactions", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "retrieve", "/v1/customers/{customer}/balance_transactions/{transaction}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomerBalanceTransactionsCmd.Cmd, "update", "/v1/customers/{customer}/balance_transactions/{transaction}", http.MethodPost, map[string]resource.Flag{
+		"description": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomerCashBalanceTransactionsCmd.Cmd, "list", "/v1/customers/{customer}/cash_balance_transactions", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomerCashBalanceTransactionsCmd.Cmd, "retrieve", "/v1/customers/{customer}/cash_balance_transactions/{transaction}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "balance_transactions", "/v1/customers/{customer}/balance_transactions", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "create", "/v1/customers", http.MethodPost, map[string]resource.Flag{
+		"balance":               {Type: "integer"},
+		"coupon":                {Type: "string"},
+		"description":           {Type: "string"},
+		"email":                 {Type: "string"},
+		"invoice_prefix":        {Type: "string"},
+		"name":                  {Type: "string"},
+		"next_invoice_sequence": {Type: "integer"},
+		"payment_method":        {Type: "string"},
+		"phone":                 {Type: "string"},
+		"promotion_code":        {Type: "string"},
+		"source":                {Type: "string"},
+		"tax_exempt":            {Type: "string"},
+		"test_clock":            {Type: "string"},
+		"validate":              {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "create_funding_instructions", "/v1/customers/{customer}/funding_instructions", http.MethodPost, map[string]resource.Flag{
+		"currency":     {Type: "string"},
+		"funding_type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "delete", "/v1/customers/{customer}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "delete_discount", "/v1/customers/{customer}/discount", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "list", "/v1/customers", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"email":          {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"test_clock":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "list_payment_methods", "/v1/customers/{customer}/payment_methods", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "retrieve", "/v1/customers/{customer}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "retrieve_payment_method", "/v1/customers/{customer}/payment_methods/{payment_method}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "search", "/v1/customers/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersCmd.Cmd, "update", "/v1/customers/{customer}", http.MethodPost, map[string]resource.Flag{
+		"balance":               {Type: "integer"},
+		"coupon":                {Type: "string"},
+		"default_source":        {Type: "string"},
+		"description":           {Type: "string"},
+		"email":                 {Type: "string"},
+		"invoice_prefix":        {Type: "string"},
+		"name":                  {Type: "string"},
+		"next_invoice_sequence": {Type: "integer"},
+		"phone":                 {Type: "string"},
+		"promotion_code":        {Type: "string"},
+		"source":                {Type: "string"},
+		"tax_exempt":            {Type: "string"},
+		"validate":              {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rCustomersTestHelpersCmd.Cmd, "fund_cash_balance", "/v1/test_helpers/customers/{customer}/fund_cash_balance", http.MethodPost, map[string]resource.Flag{
+		"amount":    {Type: "integer"},
+		"currency":  {Type: "string"},
+		"reference": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rDisputesCmd.Cmd, "close", "/v1/disputes/{dispute}/close", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rDisputesCmd.Cmd, "list", "/v1/disputes", http.MethodGet, map[string]resource.Flag{
+		"charge":         {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_intent": {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rDisputesCmd.Cmd, "retrieve", "/v1/disputes/{dispute}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rDisputesCmd.Cmd, "update", "/v1/disputes/{dispute}", http.MethodPost, map[string]resource.Flag{
+		"submit": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rEphemeralKeysCmd.Cmd, "create", "/v1/ephemeral_keys", http.MethodPost, map[string]resource.Flag{
+		"customer":     {Type: "string"},
+		"issuing_card": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rEphemeralKeysCmd.Cmd, "delete", "/v1/ephemeral_keys/{key}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rEventsCmd.Cmd, "list", "/v1/events", http.MethodGet, map[string]resource.Flag{
+		"created":          {Type: "integer"},
+		"delivery_success": {Type: "boolean"},
+		"ending_before":    {Type: "string"},
+		"limit":            {Type: "integer"},
+		"starting_after":   {Type: "string"},
+		"type":             {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rEventsCmd.Cmd, "retrieve", "/v1/events/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rExchangeRatesCmd.Cmd, "list", "/v1/exchange_rates", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rExchangeRatesCmd.Cmd, "retrieve", "/v1/exchange_rates/{rate_id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "create", "/v1/accounts/{account}/external_accounts", http.MethodPost, map[string]resource.Flag{
+		"default_for_currency": {Type: "boolean"},
+		"external_account":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "delete", "/v1/accounts/{account}/external_accounts/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "list", "/v1/accounts/{account}/external_accounts", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "retrieve", "/v1/accounts/{account}/external_accounts/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rExternalAccountsCmd.Cmd, "update", "/v1/accounts/{account}/external_accounts/{id}", http.MethodPost, map[string]resource.Flag{
+		"account_holder_name":  {Type: "string"},
+		"account_holder_type":  {Type: "string"},
+		"account_type":         {Type: "string"},
+		"address_city":         {Type: "string"},
+		"address_country":      {Type: "string"},
+		"address_line1":        {Type: "string"},
+		"address_line2":        {Type: "string"},
+		"address_state":        {Type: "string"},
+		"address_zip":          {Type: "string"},
+		"default_for_currency": {Type: "boolean"},
+		"exp_month":            {Type: "string"},
+		"exp_year":             {Type: "string"},
+		"name":                 {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "create", "/v1/application_fees/{id}/refunds", http.MethodPost, map[string]resource.Flag{
+		"amount": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "list", "/v1/application_fees/{id}/refunds", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "retrieve", "/v1/application_fees/{fee}/refunds/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFeeRefundsCmd.Cmd, "update", "/v1/application_fees/{fee}/refunds/{id}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFileLinksCmd.Cmd, "create", "/v1/file_links", http.MethodPost, map[string]resource.Flag{
+		"expires_at": {Type: "integer"},
+		"file":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFileLinksCmd.Cmd, "list", "/v1/file_links", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"expired":        {Type: "boolean"},
+		"file":           {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFileLinksCmd.Cmd, "retrieve", "/v1/file_links/{link}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFileLinksCmd.Cmd, "update", "/v1/file_links/{link}", http.MethodPost, map[string]resource.Flag{
+		"expires_at": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFilesCmd.Cmd, "create", "/v1/files", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFilesCmd.Cmd, "list", "/v1/files", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"purpose":        {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFilesCmd.Cmd, "retrieve", "/v1/files/{file}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "create", "/v1/invoiceitems", http.MethodPost, map[string]resource.Flag{
+		"amount":              {Type: "integer"},
+		"currency":            {Type: "string"},
+		"customer":            {Type: "string"},
+		"description":         {Type: "string"},
+		"discountable":        {Type: "boolean"},
+		"invoice":             {Type: "string"},
+		"price":               {Type: "string"},
+		"quantity":            {Type: "integer"},
+		"subscription":        {Type: "string"},
+		"unit_amount":         {Type: "integer"},
+		"unit_amount_decimal": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "delete", "/v1/invoiceitems/{invoiceitem}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "list", "/v1/invoiceitems", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"invoice":        {Type: "string"},
+		"limit":          {Type: "integer"},
+		"pending":        {Type: "boolean"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "retrieve", "/v1/invoiceitems/{invoiceitem}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoiceitemsCmd.Cmd, "update", "/v1/invoiceitems/{invoiceitem}", http.MethodPost, map[string]resource.Flag{
+		"amount":              {Type: "integer"},
+		"description":         {Type: "string"},
+		"discountable":        {Type: "boolean"},
+		"price":               {Type: "string"},
+		"quantity":            {Type: "integer"},
+		"unit_amount":         {Type: "integer"},
+		"unit_amount_decimal": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "create", "/v1/invoices", http.MethodPost, map[string]resource.Flag{
+		"application_fee_amount":         {Type: "integer"},
+		"auto_advance":                   {Type: "boolean"},
+		"collection_method":              {Type: "string"},
+		"currency":                       {Type: "string"},
+		"customer":                       {Type: "string"},
+		"days_until_due":                 {Type: "integer"},
+		"default_payment_method":         {Type: "string"},
+		"default_source":                 {Type: "string"},
+		"description":                    {Type: "string"},
+		"due_date":                       {Type: "integer"},
+		"footer":                         {Type: "string"},
+		"on_behalf_of":                   {Type: "string"},
+		"pending_invoice_items_behavior": {Type: "string"},
+		"statement_descriptor":           {Type: "string"},
+		"subscription":                   {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "delete", "/v1/invoices/{invoice}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "finalize_invoice", "/v1/invoices/{invoice}/finalize", http.MethodPost, map[string]resource.Flag{
+		"auto_advance": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "list", "/v1/invoices", http.MethodGet, map[string]resource.Flag{
+		"collection_method": {Type: "string"},
+		"created":           {Type: "integer"},
+		"customer":          {Type: "string"},
+		"due_date":          {Type: "integer"},
+		"ending_before":     {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+		"subscription":      {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "mark_uncollectible", "/v1/invoices/{invoice}/mark_uncollectible", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "pay", "/v1/invoices/{invoice}/pay", http.MethodPost, map[string]resource.Flag{
+		"forgive":          {Type: "boolean"},
+		"mandate":          {Type: "string"},
+		"off_session":      {Type: "boolean"},
+		"paid_out_of_band": {Type: "boolean"},
+		"payment_method":   {Type: "string"},
+		"source":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "retrieve", "/v1/invoices/{invoice}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "search", "/v1/invoices/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "send_invoice", "/v1/invoices/{invoice}/send", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "upcoming", "/v1/invoices/upcoming", http.MethodGet, map[string]resource.Flag{
+		"coupon":                            {Type: "string"},
+		"currency":                          {Type: "string"},
+		"customer":                          {Type: "string"},
+		"schedule":                          {Type: "string"},
+		"subscription":                      {Type: "string"},
+		"subscription_billing_cycle_anchor": {Type: "string"},
+		"subscription_cancel_at":            {Type: "integer"},
+		"subscription_cancel_at_period_end": {Type: "boolean"},
+		"subscription_cancel_now":           {Type: "boolean"},
+		"subscription_proration_behavior":   {Type: "string"},
+		"subscription_proration_date":       {Type: "integer"},
+		"subscription_start_date":           {Type: "integer"},
+		"subscription_trial_end":            {Type: "string"},
+		"subscription_trial_from_plan":      {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "upcomingLines", "/v1/invoices/upcoming/lines", http.MethodGet, map[string]resource.Flag{
+		"coupon":                            {Type: "string"},
+		"currency":                          {Type: "string"},
+		"customer":                          {Type: "string"},
+		"ending_before":                     {Type: "string"},
+		"limit":                             {Type: "integer"},
+		"schedule":                          {Type: "string"},
+		"starting_after":                    {Type: "string"},
+		"subscription":                      {Type: "string"},
+		"subscription_billing_cycle_anchor": {Type: "string"},
+		"subscription_cancel_at":            {Type: "integer"},
+		"subscription_cancel_at_period_end": {Type: "boolean"},
+		"subscription_cancel_now":           {Type: "boolean"},
+		"subscription_proration_behavior":   {Type: "string"},
+		"subscription_proration_date":       {Type: "integer"},
+		"subscription_start_date":           {Type: "integer"},
+		"subscription_trial_end":            {Type: "string"},
+		"subscription_trial_from_plan":      {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "update", "/v1/invoices/{invoice}", http.MethodPost, map[string]resource.Flag{
+		"application_fee_amount": {Type: "integer"},
+		"auto_advance":           {Type: "boolean"},
+		"collection_method":      {Type: "string"},
+		"days_until_due":         {Type: "integer"},
+		"default_payment_method": {Type: "string"},
+		"default_source":         {Type: "string"},
+		"description":            {Type: "string"},
+		"due_date":               {Type: "integer"},
+		"footer":                 {Type: "string"},
+		"on_behalf_of":           {Type: "string"},
+		"statement_descriptor":   {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rInvoicesCmd.Cmd, "void_invoice", "/v1/invoices/{invoice}/void", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rLineItemsCmd.Cmd, "list", "/v1/invoices/{invoice}/lines", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rLoginLinksCmd.Cmd, "create", "/v1/accounts/{account}/login_links", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rMandatesCmd.Cmd, "retrieve", "/v1/mandates/{mandate}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "cancel", "/v1/orders/{id}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "create", "/v1/orders", http.MethodPost, map[string]resource.Flag{
+		"currency":    {Type: "string"},
+		"customer":    {Type: "string"},
+		"description": {Type: "string"},
+		"ip_address":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "list", "/v1/orders", http.MethodGet, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "list_line_items", "/v1/orders/{id}/line_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "reopen", "/v1/orders/{id}/reopen", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "retrieve", "/v1/orders/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "submit", "/v1/orders/{id}/submit", http.MethodPost, map[string]resource.Flag{
+		"expected_total": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rOrdersCmd.Cmd, "update", "/v1/orders/{id}", http.MethodPost, map[string]resource.Flag{
+		"currency":    {Type: "string"},
+		"customer":    {Type: "string"},
+		"description": {Type: "string"},
+		"ip_address":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "apply_customer_balance", "/v1/payment_intents/{intent}/apply_customer_balance", http.MethodPost, map[string]resource.Flag{
+		"amount":   {Type: "integer"},
+		"currency": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "cancel", "/v1/payment_intents/{intent}/cancel", http.MethodPost, map[string]resource.Flag{
+		"cancellation_reason": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "capture", "/v1/payment_intents/{intent}/capture", http.MethodPost, map[string]resource.Flag{
+		"amount_to_capture":           {Type: "integer"},
+		"application_fee_amount":      {Type: "integer"},
+		"statement_descriptor":        {Type: "string"},
+		"statement_descriptor_suffix": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "confirm", "/v1/payment_intents/{intent}/confirm", http.MethodPost, map[string]resource.Flag{
+		"capture_method":           {Type: "string"},
+		"error_on_requires_action": {Type: "boolean"},
+		"mandate":                  {Type: "string"},
+		"off_session":              {Type: "boolean"},
+		"payment_method":           {Type: "string"},
+		"receipt_email":            {Type: "string"},
+		"return_url":               {Type: "string"},
+		"setup_future_usage":       {Type: "string"},
+		"use_stripe_sdk":           {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "create", "/v1/payment_intents", http.MethodPost, map[string]resource.Flag{
+		"amount":                      {Type: "integer"},
+		"application_fee_amount":      {Type: "integer"},
+		"capture_method":              {Type: "string"},
+		"confirm":                     {Type: "boolean"},
+		"confirmation_method":         {Type: "string"},
+		"currency":                    {Type: "string"},
+		"customer":                    {Type: "string"},
+		"description":                 {Type: "string"},
+		"error_on_requires_action":    {Type: "boolean"},
+		"mandate":                     {Type: "string"},
+		"off_session":                 {Type: "boolean"},
+		"on_behalf_of":                {Type: "string"},
+		"payment_method":              {Type: "string"},
+		"receipt_email":               {Type: "string"},
+		"return_url":                  {Type: "string"},
+		"setup_future_usage":          {Type: "string"},
+		"statement_descriptor":        {Type: "string"},
+		"statement_descriptor_suffix": {Type: "string"},
+		"transfer_group":              {Type: "string"},
+		"use_stripe_sdk":              {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "increment_authorization", "/v1/payment_intents/{intent}/increment_authorization", http.MethodPost, map[string]resource.Flag{
+		"amount":                 {Type: "integer"},
+		"application_fee_amount": {Type: "integer"},
+		"description":            {Type: "string"},
+		"statement_descriptor":   {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "list", "/v1/payment_intents", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "retrieve", "/v1/payment_intents/{intent}", http.MethodGet, map[string]resource.Flag{
+		"client_secret": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "search", "/v1/payment_intents/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "update", "/v1/payment_intents/{intent}", http.MethodPost, map[string]resource.Flag{
+		"amount":                      {Type: "integer"},
+		"application_fee_amount":      {Type: "integer"},
+		"capture_method":              {Type: "string"},
+		"currency":                    {Type: "string"},
+		"customer":                    {Type: "string"},
+		"description":                 {Type: "string"},
+		"payment_method":              {Type: "string"},
+		"receipt_email":               {Type: "string"},
+		"setup_future_usage":          {Type: "string"},
+		"statement_descriptor":        {Type: "string"},
+		"statement_descriptor_suffix": {Type: "string"},
+		"transfer_group":              {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentIntentsCmd.Cmd, "verify_microdeposits", "/v1/payment_intents/{intent}/verify_microdeposits", http.MethodPost, map[string]resource.Flag{
+		"descriptor_code": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "create", "/v1/payment_links", http.MethodPost, map[string]resource.Flag{
+		"allow_promotion_codes":      {Type: "boolean"},
+		"application_fee_amount":     {Type: "integer"},
+		"application_fee_percent":    {Type: "number"},
+		"billing_address_collection": {Type: "string"},
+		"currency":                   {Type: "string"},
+		"customer_creation":          {Type: "string"},
+		"on_behalf_of":               {Type: "string"},
+		"payment_method_collection":  {Type: "string"},
+		"submit_type":                {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "list", "/v1/payment_links", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "list_line_items", "/v1/payment_links/{payment_link}/line_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "retrieve", "/v1/payment_links/{payment_link}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPaymentLinksCmd.Cmd, "update", "/v1/payment_links/{payment_link}", http.MethodPost, map[string]resource.Flag{
+		"active":                     {Type: "boolean"},
+		"allow_promotion_codes":      {Type: "boolean"},
+		"billing_address_collection": {Type: "string"},
+		"customer_creation":          {Type: "string"},
+		"payment_method_collection":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "attach", "/v1/payment_methods/{payment_method}/attach", http.MethodPost, map[string]resource.Flag{
+		"customer": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "create", "/v1/payment_methods", http.MethodPost, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"payment_method": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "detach", "/v1/payment_methods/{payment_method}/detach", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "list", "/v1/payment_methods", http.MethodGet, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "retrieve", "/v1/payment_methods/{payment_method}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPaymentMethodsCmd.Cmd, "update", "/v1/payment_methods/{payment_method}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "create", "/v1/customers/{customer}/sources", http.MethodPost, map[string]resource.Flag{
+		"source":   {Type: "string"},
+		"validate": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "list", "/v1/customers/{customer}/sources", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"object":         {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPaymentSourcesCmd.Cmd, "retrieve", "/v1/customers/{customer}/sources/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "cancel", "/v1/payouts/{payout}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "create", "/v1/payouts", http.MethodPost, map[string]resource.Flag{
+		"amount":               {Type: "integer"},
+		"currency":             {Type: "string"},
+		"description":          {Type: "string"},
+		"destination":          {Type: "string"},
+		"method":               {Type: "string"},
+		"source_type":          {Type: "string"},
+		"statement_descriptor": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "list", "/v1/payouts", http.MethodGet, map[string]resource.Flag{
+		"arrival_date":   {Type: "integer"},
+		"created":        {Type: "integer"},
+		"destination":    {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "retrieve", "/v1/payouts/{payout}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "reverse", "/v1/payouts/{payout}/reverse", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPayoutsCmd.Cmd, "update", "/v1/payouts/{payout}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPersonsCmd.Cmd, "create", "/v1/accounts/{account}/persons", http.MethodPost, map[string]resource.Flag{
+		"email":               {Type: "string"},
+		"first_name":          {Type: "string"},
+		"first_name_kana":     {Type: "string"},
+		"first_name_kanji":    {Type: "string"},
+		"gender":              {Type: "string"},
+		"id_number":           {Type: "string"},
+		"id_number_secondary": {Type: "string"},
+		"last_name":           {Type: "string"},
+		"last_name_kana":      {Type: "string"},
+		"last_name_kanji":     {Type: "string"},
+		"maiden_name":         {Type: "string"},
+		"nationality":         {Type: "string"},
+		"person_token":        {Type: "string"},
+		"phone":               {Type: "string"},
+		"political_exposure":  {Type: "string"},
+		"ssn_last_4":          {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPersonsCmd.Cmd, "delete", "/v1/accounts/{account}/persons/{person}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPersonsCmd.Cmd, "list", "/v1/accounts/{account}/persons", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPersonsCmd.Cmd, "retrieve", "/v1/accounts/{account}/persons/{person}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPersonsCmd.Cmd, "update", "/v1/accounts/{account}/persons/{person}", http.MethodPost, map[string]resource.Flag{
+		"email":               {Type: "string"},
+		"first_name":          {Type: "string"},
+		"first_name_kana":     {Type: "string"},
+		"first_name_kanji":    {Type: "string"},
+		"gender":              {Type: "string"},
+		"id_number":           {Type: "string"},
+		"id_number_secondary": {Type: "string"},
+		"last_name":           {Type: "string"},
+		"last_name_kana":      {Type: "string"},
+		"last_name_kanji":     {Type: "string"},
+		"maiden_name":         {Type: "string"},
+		"nationality":         {Type: "string"},
+		"person_token":        {Type: "string"},
+		"phone":               {Type: "string"},
+		"political_exposure":  {Type: "string"},
+		"ssn_last_4":          {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPlansCmd.Cmd, "create", "/v1/plans", http.MethodPost, map[string]resource.Flag{
+		"active":            {Type: "boolean"},
+		"aggregate_usage":   {Type: "string"},
+		"amount":            {Type: "integer"},
+		"amount_decimal":    {Type: "string"},
+		"billing_scheme":    {Type: "string"},
+		"currency":          {Type: "string"},
+		"id":                {Type: "string"},
+		"interval":          {Type: "string"},
+		"interval_count":    {Type: "integer"},
+		"nickname":          {Type: "string"},
+		"product":           {Type: "string"},
+		"tiers_mode":        {Type: "string"},
+		"trial_period_days": {Type: "integer"},
+		"usage_type":        {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPlansCmd.Cmd, "delete", "/v1/plans/{plan}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPlansCmd.Cmd, "list", "/v1/plans", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"product":        {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPlansCmd.Cmd, "retrieve", "/v1/plans/{plan}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPlansCmd.Cmd, "update", "/v1/plans/{plan}", http.MethodPost, map[string]resource.Flag{
+		"active":            {Type: "boolean"},
+		"nickname":          {Type: "string"},
+		"product":           {Type: "string"},
+		"trial_period_days": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rPricesCmd.Cmd, "create", "/v1/prices", http.MethodPost, map[string]resource.Flag{
+		"active":              {Type: "boolean"},
+		"billing_scheme":      {Type: "string"},
+		"currency":            {Type: "string"},
+		"lookup_key":          {Type: "string"},
+		"nickname":            {Type: "string"},
+		"product":             {Type: "string"},
+		"tax_behavior":        {Type: "string"},
+		"tiers_mode":          {Type: "string"},
+		"transfer_lookup_key": {Type: "boolean"},
+		"unit_amount":         {Type: "integer"},
+		"unit_amount_decimal": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPricesCmd.Cmd, "list", "/v1/prices", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"currency":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"product":        {Type: "string"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPricesCmd.Cmd, "retrieve", "/v1/prices/{price}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPricesCmd.Cmd, "search", "/v1/prices/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPricesCmd.Cmd, "update", "/v1/prices/{price}", http.MethodPost, map[string]resource.Flag{
+		"active":              {Type: "boolean"},
+		"lookup_key":          {Type: "string"},
+		"nickname":            {Type: "string"},
+		"tax_behavior":        {Type: "string"},
+		"transfer_lookup_key": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "create", "/v1/products", http.MethodPost, map[string]resource.Flag{
+		"active":               {Type: "boolean"},
+		"caption":              {Type: "string"},
+		"description":          {Type: "string"},
+		"id":                   {Type: "string"},
+		"name":                 {Type: "string"},
+		"shippable":            {Type: "boolean"},
+		"statement_descriptor": {Type: "string"},
+		"tax_code":             {Type: "string"},
+		"type":                 {Type: "string"},
+		"unit_label":           {Type: "string"},
+		"url":                  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "delete", "/v1/products/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "list", "/v1/products", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"shippable":      {Type: "boolean"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+		"url":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "retrieve", "/v1/products/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "search", "/v1/products/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rProductsCmd.Cmd, "update", "/v1/products/{id}", http.MethodPost, map[string]resource.Flag{
+		"active":               {Type: "boolean"},
+		"caption":              {Type: "string"},
+		"default_price":        {Type: "string"},
+		"description":          {Type: "string"},
+		"name":                 {Type: "string"},
+		"shippable":            {Type: "boolean"},
+		"statement_descriptor": {Type: "string"},
+		"tax_code":             {Type: "string"},
+		"unit_label":           {Type: "string"},
+		"url":                  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "create", "/v1/promotion_codes", http.MethodPost, map[string]resource.Flag{
+		"active":          {Type: "boolean"},
+		"code":            {Type: "string"},
+		"coupon":          {Type: "string"},
+		"customer":        {Type: "string"},
+		"expires_at":      {Type: "integer"},
+		"max_redemptions": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "list", "/v1/promotion_codes", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"code":           {Type: "string"},
+		"coupon":         {Type: "string"},
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "retrieve", "/v1/promotion_codes/{promotion_code}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rPromotionCodesCmd.Cmd, "update", "/v1/promotion_codes/{promotion_code}", http.MethodPost, map[string]resource.Flag{
+		"active": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "accept", "/v1/quotes/{quote}/accept", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "cancel", "/v1/quotes/{quote}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "create", "/v1/quotes", http.MethodPost, map[string]resource.Flag{
+		"application_fee_amount":  {Type: "integer"},
+		"application_fee_percent": {Type: "number"},
+		"collection_method":       {Type: "string"},
+		"customer":                {Type: "string"},
+		"description":             {Type: "string"},
+		"expires_at":              {Type: "integer"},
+		"footer":                  {Type: "string"},
+		"header":                  {Type: "string"},
+		"on_behalf_of":            {Type: "string"},
+		"test_clock":              {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "finalize_quote", "/v1/quotes/{quote}/finalize", http.MethodPost, map[string]resource.Flag{
+		"expires_at": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "list", "/v1/quotes", http.MethodGet, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+		"test_clock":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "list_computed_upfront_line_items", "/v1/quotes/{quote}/computed_upfront_line_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "list_line_items", "/v1/quotes/{quote}/line_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "pdf", "/v1/quotes/{quote}/pdf", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "retrieve", "/v1/quotes/{quote}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rQuotesCmd.Cmd, "update", "/v1/quotes/{quote}", http.MethodPost, map[string]resource.Flag{
+		"application_fee_amount":  {Type: "integer"},
+		"application_fee_percent": {Type: "number"},
+		"collection_method":       {Type: "string"},
+		"customer":                {Type: "string"},
+		"description":             {Type: "string"},
+		"expires_at":              {Type: "integer"},
+		"footer":                  {Type: "string"},
+		"header":                  {Type: "string"},
+		"on_behalf_of":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRefundsCmd.Cmd, "cancel", "/v1/refunds/{refund}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRefundsCmd.Cmd, "create", "/v1/refunds", http.MethodPost, map[string]resource.Flag{
+		"amount":                 {Type: "integer"},
+		"charge":                 {Type: "string"},
+		"currency":               {Type: "string"},
+		"customer":               {Type: "string"},
+		"instructions_email":     {Type: "string"},
+		"origin":                 {Type: "string"},
+		"payment_intent":         {Type: "string"},
+		"reason":                 {Type: "string"},
+		"refund_application_fee": {Type: "boolean"},
+		"reverse_transfer":       {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rRefundsCmd.Cmd, "list", "/v1/refunds", http.MethodGet, map[string]resource.Flag{
+		"charge":         {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_intent": {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRefundsCmd.Cmd, "retrieve", "/v1/refunds/{refund}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRefundsCmd.Cmd, "update", "/v1/refunds/{refund}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRefundsTestHelpersCmd.Cmd, "expire", "/v1/test_helpers/refunds/{refund}/expire", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rReviewsCmd.Cmd, "approve", "/v1/reviews/{review}/approve", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rReviewsCmd.Cmd, "list", "/v1/reviews", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rReviewsCmd.Cmd, "retrieve", "/v1/reviews/{review}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rScheduledQueryRunsCmd.Cmd, "list", "/v1/sigma/scheduled_query_runs", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rScheduledQueryRunsCmd.Cmd, "retrieve", "/v1/sigma/scheduled_query_runs/{scheduled_query_run}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSetupAttemptsCmd.Cmd, "list", "/v1/setup_attempts", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"setup_intent":   {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "cancel", "/v1/setup_intents/{intent}/cancel", http.MethodPost, map[string]resource.Flag{
+		"cancellation_reason": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "confirm", "/v1/setup_intents/{intent}/confirm", http.MethodPost, map[string]resource.Flag{
+		"payment_method": {Type: "string"},
+		"return_url":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "create", "/v1/setup_intents", http.MethodPost, map[string]resource.Flag{
+		"attach_to_self": {Type: "boolean"},
+		"confirm":        {Type: "boolean"},
+		"customer":       {Type: "string"},
+		"description":    {Type: "string"},
+		"on_behalf_of":   {Type: "string"},
+		"payment_method": {Type: "string"},
+		"return_url":     {Type: "string"},
+		"usage":          {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "list", "/v1/setup_intents", http.MethodGet, map[string]resource.Flag{
+		"attach_to_self": {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_method": {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "retrieve", "/v1/setup_intents/{intent}", http.MethodGet, map[string]resource.Flag{
+		"client_secret": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "update", "/v1/setup_intents/{intent}", http.MethodPost, map[string]resource.Flag{
+		"attach_to_self": {Type: "boolean"},
+		"customer":       {Type: "string"},
+		"description":    {Type: "string"},
+		"payment_method": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSetupIntentsCmd.Cmd, "verify_microdeposits", "/v1/setup_intents/{intent}/verify_microdeposits", http.MethodPost, map[string]resource.Flag{
+		"descriptor_code": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "create", "/v1/shipping_rates", http.MethodPost, map[string]resource.Flag{
+		"display_name": {Type: "string"},
+		"tax_behavior": {Type: "string"},
+		"tax_code":     {Type: "string"},
+		"type":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "list", "/v1/shipping_rates", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"currency":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "retrieve", "/v1/shipping_rates/{shipping_rate_token}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rShippingRatesCmd.Cmd, "update", "/v1/shipping_rates/{shipping_rate_token}", http.MethodPost, map[string]resource.Flag{
+		"active":       {Type: "boolean"},
+		"tax_behavior": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSkusCmd.Cmd, "create", "/v1/skus", http.MethodPost, map[string]resource.Flag{
+		"active":   {Type: "boolean"},
+		"currency": {Type: "string"},
+		"id":       {Type: "string"},
+		"image":    {Type: "string"},
+		"price":    {Type: "integer"},
+		"product":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSkusCmd.Cmd, "delete", "/v1/skus/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSkusCmd.Cmd, "list", "/v1/skus", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"ending_before":  {Type: "string"},
+		"in_stock":       {Type: "boolean"},
+		"limit":          {Type: "integer"},
+		"product":        {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSkusCmd.Cmd, "retrieve", "/v1/skus/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSkusCmd.Cmd, "update", "/v1/skus/{id}", http.MethodPost, map[string]resource.Flag{
+		"active":   {Type: "boolean"},
+		"currency": {Type: "string"},
+		"image":    {Type: "string"},
+		"price":    {Type: "integer"},
+		"product":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "create", "/v1/sources", http.MethodPost, map[string]resource.Flag{
+		"amount":               {Type: "integer"},
+		"currency":             {Type: "string"},
+		"customer":             {Type: "string"},
+		"flow":                 {Type: "string"},
+		"original_source":      {Type: "string"},
+		"statement_descriptor": {Type: "string"},
+		"token":                {Type: "string"},
+		"type":                 {Type: "string"},
+		"usage":                {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "detach", "/v1/customers/{customer}/sources/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "retrieve", "/v1/sources/{source}", http.MethodGet, map[string]resource.Flag{
+		"client_secret": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "source_transactions", "/v1/sources/{source}/source_transactions", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "update", "/v1/sources/{source}", http.MethodPost, map[string]resource.Flag{
+		"amount": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rSourcesCmd.Cmd, "verify", "/v1/sources/{source}/verify", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "create", "/v1/subscription_items", http.MethodPost, map[string]resource.Flag{
+		"payment_behavior":   {Type: "string"},
+		"plan":               {Type: "string"},
+		"price":              {Type: "string"},
+		"proration_behavior": {Type: "string"},
+		"proration_date":     {Type: "integer"},
+		"quantity":           {Type: "integer"},
+		"subscription":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "delete", "/v1/subscription_items/{item}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "list", "/v1/subscription_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"subscription":   {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "retrieve", "/v1/subscription_items/{item}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "update", "/v1/subscription_items/{item}", http.MethodPost, map[string]resource.Flag{
+		"off_session":        {Type: "boolean"},
+		"payment_behavior":   {Type: "string"},
+		"plan":               {Type: "string"},
+		"price":              {Type: "string"},
+		"proration_behavior": {Type: "string"},
+		"proration_date":     {Type: "integer"},
+		"quantity":           {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionItemsCmd.Cmd, "usage_record_summaries", "/v1/subscription_items/{subscription_item}/usage_record_summaries", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "cancel", "/v1/subscription_schedules/{schedule}/cancel", http.MethodPost, map[string]resource.Flag{
+		"invoice_now": {Type: "boolean"},
+		"prorate":     {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "create", "/v1/subscription_schedules", http.MethodPost, map[string]resource.Flag{
+		"customer":          {Type: "string"},
+		"end_behavior":      {Type: "string"},
+		"from_subscription": {Type: "string"},
+		"start_date":        {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "list", "/v1/subscription_schedules", http.MethodGet, map[string]resource.Flag{
+		"canceled_at":    {Type: "integer"},
+		"completed_at":   {Type: "integer"},
+		"created":        {Type: "integer"},
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"released_at":    {Type: "integer"},
+		"scheduled":      {Type: "boolean"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "release", "/v1/subscription_schedules/{schedule}/release", http.MethodPost, map[string]resource.Flag{
+		"preserve_cancel_date": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "retrieve", "/v1/subscription_schedules/{schedule}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionSchedulesCmd.Cmd, "update", "/v1/subscription_schedules/{schedule}", http.MethodPost, map[string]resource.Flag{
+		"end_behavior":       {Type: "string"},
+		"proration_behavior": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "cancel", "/v1/subscriptions/{subscription_exposed_id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "create", "/v1/subscriptions", http.MethodPost, map[string]resource.Flag{
+		"application_fee_percent": {Type: "number"},
+		"backdate_start_date":     {Type: "integer"},
+		"billing_cycle_anchor":    {Type: "integer"},
+		"cancel_at":               {Type: "integer"},
+		"cancel_at_period_end":    {Type: "boolean"},
+		"collection_method":       {Type: "string"},
+		"coupon":                  {Type: "string"},
+		"currency":                {Type: "string"},
+		"customer":                {Type: "string"},
+		"days_until_due":          {Type: "integer"},
+		"default_payment_method":  {Type: "string"},
+		"default_source":          {Type: "string"},
+		"description":             {Type: "string"},
+		"off_session":             {Type: "boolean"},
+		"payment_behavior":        {Type: "string"},
+		"promotion_code":          {Type: "string"},
+		"proration_behavior":      {Type: "string"},
+		"trial_end":               {Type: "string"},
+		"trial_from_plan":         {Type: "boolean"},
+		"trial_period_days":       {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "delete_discount", "/v1/subscriptions/{subscription_exposed_id}/discount", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "list", "/v1/subscriptions", http.MethodGet, map[string]resource.Flag{
+		"collection_method":    {Type: "string"},
+		"created":              {Type: "integer"},
+		"current_period_end":   {Type: "integer"},
+		"current_period_start": {Type: "integer"},
+		"customer":             {Type: "string"},
+		"ending_before":        {Type: "string"},
+		"limit":                {Type: "integer"},
+		"plan":                 {Type: "string"},
+		"price":                {Type: "string"},
+		"starting_after":       {Type: "string"},
+		"status":               {Type: "string"},
+		"test_clock":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "retrieve", "/v1/subscriptions/{subscription_exposed_id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "search", "/v1/subscriptions/search", http.MethodGet, map[string]resource.Flag{
+		"limit": {Type: "integer"},
+		"page":  {Type: "string"},
+		"query": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rSubscriptionsCmd.Cmd, "update", "/v1/subscriptions/{subscription_exposed_id}", http.MethodPost, map[string]resource.Flag{
+		"application_fee_percent": {Type: "number"},
+		"billing_cycle_anchor":    {Type: "string"},
+		"cancel_at":               {Type: "integer"},
+		"cancel_at_period_end":    {Type: "boolean"},
+		"collection_method":       {Type: "string"},
+		"coupon":                  {Type: "string"},
+		"days_until_due":          {Type: "integer"},
+		"default_payment_method":  {Type: "string"},
+		"default_source":          {Type: "string"},
+		"description":             {Type: "string"},
+		"off_session":             {Type: "boolean"},
+		"payment_behavior":        {Type: "string"},
+		"promotion_code":          {Type: "string"},
+		"proration_behavior":      {Type: "string"},
+		"proration_date":          {Type: "integer"},
+		"trial_end":               {Type: "string"},
+		"trial_from_plan":         {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxCodesCmd.Cmd, "list", "/v1/tax_codes", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxCodesCmd.Cmd, "retrieve", "/v1/tax_codes/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "create", "/v1/customers/{customer}/tax_ids", http.MethodPost, map[string]resource.Flag{
+		"type":  {Type: "string"},
+		"value": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "delete", "/v1/customers/{customer}/tax_ids/{id}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "list", "/v1/customers/{customer}/tax_ids", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxIdsCmd.Cmd, "retrieve", "/v1/customers/{customer}/tax_ids/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "create", "/v1/tax_rates", http.MethodPost, map[string]resource.Flag{
+		"active":       {Type: "boolean"},
+		"country":      {Type: "string"},
+		"description":  {Type: "string"},
+		"display_name": {Type: "string"},
+		"inclusive":    {Type: "boolean"},
+		"jurisdiction": {Type: "string"},
+		"percentage":   {Type: "number"},
+		"state":        {Type: "string"},
+		"tax_type":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "list", "/v1/tax_rates", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"inclusive":      {Type: "boolean"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "retrieve", "/v1/tax_rates/{tax_rate}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTaxRatesCmd.Cmd, "update", "/v1/tax_rates/{tax_rate}", http.MethodPost, map[string]resource.Flag{
+		"active":       {Type: "boolean"},
+		"country":      {Type: "string"},
+		"description":  {Type: "string"},
+		"display_name": {Type: "string"},
+		"jurisdiction": {Type: "string"},
+		"state":        {Type: "string"},
+		"tax_type":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTokensCmd.Cmd, "create", "/v1/tokens", http.MethodPost, map[string]resource.Flag{
+		"card":     {Type: "string"},
+		"customer": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTokensCmd.Cmd, "retrieve", "/v1/tokens/{token}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTopupsCmd.Cmd, "cancel", "/v1/topups/{topup}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTopupsCmd.Cmd, "create", "/v1/topups", http.MethodPost, map[string]resource.Flag{
+		"amount":               {Type: "integer"},
+		"currency":             {Type: "string"},
+		"description":          {Type: "string"},
+		"source":               {Type: "string"},
+		"statement_descriptor": {Type: "string"},
+		"transfer_group":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTopupsCmd.Cmd, "list", "/v1/topups", http.MethodGet, map[string]resource.Flag{
+		"amount":         {Type: "integer"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTopupsCmd.Cmd, "retrieve", "/v1/topups/{topup}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTopupsCmd.Cmd, "update", "/v1/topups/{topup}", http.MethodPost, map[string]resource.Flag{
+		"description": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "create", "/v1/transfers/{id}/reversals", http.MethodPost, map[string]resource.Flag{
+		"amount":                 {Type: "integer"},
+		"description":            {Type: "string"},
+		"refund_application_fee": {Type: "boolean"},
+	}, &Config)
+	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "list", "/v1/transfers/{id}/reversals", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "retrieve", "/v1/transfers/{transfer}/reversals/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTransferReversalsCmd.Cmd, "update", "/v1/transfers/{transfer}/reversals/{id}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTransfersCmd.Cmd, "create", "/v1/transfers", http.MethodPost, map[string]resource.Flag{
+		"amount":             {Type: "integer"},
+		"currency":           {Type: "string"},
+		"description":        {Type: "string"},
+		"destination":        {Type: "string"},
+		"source_transaction": {Type: "string"},
+		"source_type":        {Type: "string"},
+		"transfer_group":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTransfersCmd.Cmd, "list", "/v1/transfers", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"destination":    {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"transfer_group": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTransfersCmd.Cmd, "retrieve", "/v1/transfers/{transfer}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTransfersCmd.Cmd, "update", "/v1/transfers/{transfer}", http.MethodPost, map[string]resource.Flag{
+		"description": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rUsageRecordSummariesCmd.Cmd, "list", "/v1/subscription_items/{subscription_item}/usage_record_summaries", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rUsageRecordsCmd.Cmd, "create", "/v1/subscription_items/{subscription_item}/usage_records", http.MethodPost, map[string]resource.Flag{
+		"action":    {Type: "string"},
+		"quantity":  {Type: "integer"},
+		"timestamp": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "create", "/v1/webhook_endpoints", http.MethodPost, map[string]resource.Flag{
+		"api_version": {Type: "string"},
+		"connect":     {Type: "boolean"},
+		"description": {Type: "string"},
+		"url":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "delete", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "list", "/v1/webhook_endpoints", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "retrieve", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rWebhookEndpointsCmd.Cmd, "update", "/v1/webhook_endpoints/{webhook_endpoint}", http.MethodPost, map[string]resource.Flag{
+		"description": {Type: "string"},
+		"disabled":    {Type: "boolean"},
+		"url":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "create", "/v1/apps/secrets", http.MethodPost, map[string]resource.Flag{
+		"expires_at": {Type: "integer"},
+		"name":       {Type: "string"},
+		"payload":    {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "delete_where", "/v1/apps/secrets/delete", http.MethodPost, map[string]resource.Flag{
+		"name": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "find", "/v1/apps/secrets/find", http.MethodGet, map[string]resource.Flag{
+		"name": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rAppsSecretsCmd.Cmd, "list", "/v1/apps/secrets", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "create", "/v1/billing_portal/configurations", http.MethodPost, map[string]resource.Flag{
+		"default_return_url": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "list", "/v1/billing_portal/configurations", http.MethodGet, map[string]resource.Flag{
+		"active":         {Type: "boolean"},
+		"ending_before":  {Type: "string"},
+		"is_default":     {Type: "boolean"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "retrieve", "/v1/billing_portal/configurations/{configuration}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rBillingPortalConfigurationsCmd.Cmd, "update", "/v1/billing_portal/configurations/{configuration}", http.MethodPost, map[string]resource.Flag{
+		"active":             {Type: "boolean"},
+		"default_return_url": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rBillingPortalSessionsCmd.Cmd, "create", "/v1/billing_portal/sessions", http.MethodPost, map[string]resource.Flag{
+		"configuration": {Type: "string"},
+		"customer":      {Type: "string"},
+		"locale":        {Type: "string"},
+		"on_behalf_of":  {Type: "string"},
+		"return_url":    {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "create", "/v1/checkout/sessions", http.MethodPost, map[string]resource.Flag{
+		"allow_promotion_codes":      {Type: "boolean"},
+		"billing_address_collection": {Type: "string"},
+		"cancel_url":                 {Type: "string"},
+		"client_reference_id":        {Type: "string"},
+		"currency":                   {Type: "string"},
+		"customer":                   {Type: "string"},
+		"customer_creation":          {Type: "string"},
+		"customer_email":             {Type: "string"},
+		"expires_at":                 {Type: "integer"},
+		"locale":                     {Type: "string"},
+		"mode":                       {Type: "string"},
+		"payment_method_collection":  {Type: "string"},
+		"submit_type":                {Type: "string"},
+		"success_url":                {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "expire", "/v1/checkout/sessions/{session}/expire", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "list", "/v1/checkout/sessions", http.MethodGet, map[string]resource.Flag{
+		"customer":       {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_intent": {Type: "string"},
+		"starting_after": {Type: "string"},
+		"subscription":   {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "list_line_items", "/v1/checkout/sessions/{session}/line_items", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rCheckoutSessionsCmd.Cmd, "retrieve", "/v1/checkout/sessions/{session}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "disconnect", "/v1/financial_connections/accounts/{account}/disconnect", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "list", "/v1/financial_connections/accounts", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"session":        {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "list_owners", "/v1/financial_connections/accounts/{account}/owners", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"ownership":      {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "refresh", "/v1/financial_connections/accounts/{account}/refresh", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsAccountsCmd.Cmd, "retrieve", "/v1/financial_connections/accounts/{account}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsSessionsCmd.Cmd, "create", "/v1/financial_connections/sessions", http.MethodPost, map[string]resource.Flag{
+		"return_url": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rFinancialConnectionsSessionsCmd.Cmd, "retrieve", "/v1/financial_connections/sessions/{session}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationReportsCmd.Cmd, "list", "/v1/identity/verification_reports", http.MethodGet, map[string]resource.Flag{
+		"created":              {Type: "integer"},
+		"ending_before":        {Type: "string"},
+		"limit":                {Type: "integer"},
+		"starting_after":       {Type: "string"},
+		"type":                 {Type: "string"},
+		"verification_session": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationReportsCmd.Cmd, "retrieve", "/v1/identity/verification_reports/{report}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "cancel", "/v1/identity/verification_sessions/{session}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "create", "/v1/identity/verification_sessions", http.MethodPost, map[string]resource.Flag{
+		"return_url": {Type: "string"},
+		"type":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "list", "/v1/identity/verification_sessions", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "redact", "/v1/identity/verification_sessions/{session}/redact", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "retrieve", "/v1/identity/verification_sessions/{session}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIdentityVerificationSessionsCmd.Cmd, "update", "/v1/identity/verification_sessions/{session}", http.MethodPost, map[string]resource.Flag{
+		"type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "approve", "/v1/issuing/authorizations/{authorization}/approve", http.MethodPost, map[string]resource.Flag{
+		"amount": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "decline", "/v1/issuing/authorizations/{authorization}/decline", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "list", "/v1/issuing/authorizations", http.MethodGet, map[string]resource.Flag{
+		"card":           {Type: "string"},
+		"cardholder":     {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "retrieve", "/v1/issuing/authorizations/{authorization}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingAuthorizationsCmd.Cmd, "update", "/v1/issuing/authorizations/{authorization}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "create", "/v1/issuing/cardholders", http.MethodPost, map[string]resource.Flag{
+		"email":        {Type: "string"},
+		"name":         {Type: "string"},
+		"phone_number": {Type: "string"},
+		"status":       {Type: "string"},
+		"type":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "list", "/v1/issuing/cardholders", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"email":          {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"phone_number":   {Type: "string"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "retrieve", "/v1/issuing/cardholders/{cardholder}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardholdersCmd.Cmd, "update", "/v1/issuing/cardholders/{cardholder}", http.MethodPost, map[string]resource.Flag{
+		"email":        {Type: "string"},
+		"phone_number": {Type: "string"},
+		"status":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "create", "/v1/issuing/cards", http.MethodPost, map[string]resource.Flag{
+		"cardholder":         {Type: "string"},
+		"currency":           {Type: "string"},
+		"financial_account":  {Type: "string"},
+		"replacement_for":    {Type: "string"},
+		"replacement_reason": {Type: "string"},
+		"status":             {Type: "string"},
+		"type":               {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "list", "/v1/issuing/cards", http.MethodGet, map[string]resource.Flag{
+		"cardholder":     {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"exp_month":      {Type: "integer"},
+		"exp_year":       {Type: "integer"},
+		"last4":          {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "retrieve", "/v1/issuing/cards/{card}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardsCmd.Cmd, "update", "/v1/issuing/cards/{card}", http.MethodPost, map[string]resource.Flag{
+		"cancellation_reason": {Type: "string"},
+		"status":              {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "deliver_card", "/v1/test_helpers/issuing/cards/{card}/shipping/deliver", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "fail_card", "/v1/test_helpers/issuing/cards/{card}/shipping/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "return_card", "/v1/test_helpers/issuing/cards/{card}/shipping/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingCardsTestHelpersCmd.Cmd, "ship_card", "/v1/test_helpers/issuing/cards/{card}/shipping/ship", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "create", "/v1/issuing/disputes", http.MethodPost, map[string]resource.Flag{
+		"amount":      {Type: "integer"},
+		"transaction": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "list", "/v1/issuing/disputes", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+		"transaction":    {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "retrieve", "/v1/issuing/disputes/{dispute}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "submit", "/v1/issuing/disputes/{dispute}/submit", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingDisputesCmd.Cmd, "update", "/v1/issuing/disputes/{dispute}", http.MethodPost, map[string]resource.Flag{
+		"amount": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "list", "/v1/issuing/transactions", http.MethodGet, map[string]resource.Flag{
+		"card":           {Type: "string"},
+		"cardholder":     {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"type":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "retrieve", "/v1/issuing/transactions/{transaction}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rIssuingTransactionsCmd.Cmd, "update", "/v1/issuing/transactions/{transaction}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarEarlyFraudWarningsCmd.Cmd, "list", "/v1/radar/early_fraud_warnings", http.MethodGet, map[string]resource.Flag{
+		"charge":         {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"payment_intent": {Type: "string"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRadarEarlyFraudWarningsCmd.Cmd, "retrieve", "/v1/radar/early_fraud_warnings/{early_fraud_warning}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "create", "/v1/radar/value_list_items", http.MethodPost, map[string]resource.Flag{
+		"value":      {Type: "string"},
+		"value_list": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "delete", "/v1/radar/value_list_items/{item}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "list", "/v1/radar/value_list_items", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+		"value":          {Type: "string"},
+		"value_list":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRadarValueListItemsCmd.Cmd, "retrieve", "/v1/radar/value_list_items/{item}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "create", "/v1/radar/value_lists", http.MethodPost, map[string]resource.Flag{
+		"alias":     {Type: "string"},
+		"item_type": {Type: "string"},
+		"name":      {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "delete", "/v1/radar/value_lists/{value_list}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "list", "/v1/radar/value_lists", http.MethodGet, map[string]resource.Flag{
+		"alias":          {Type: "string"},
+		"contains":       {Type: "string"},
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "retrieve", "/v1/radar/value_lists/{value_list}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rRadarValueListsCmd.Cmd, "update", "/v1/radar/value_lists/{value_list}", http.MethodPost, map[string]resource.Flag{
+		"alias": {Type: "string"},
+		"name":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "create", "/v1/reporting/report_runs", http.MethodPost, map[string]resource.Flag{
+		"report_type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "list", "/v1/reporting/report_runs", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rReportingReportRunsCmd.Cmd, "retrieve", "/v1/reporting/report_runs/{report_run}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rReportingReportTypesCmd.Cmd, "list", "/v1/reporting/report_types", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rReportingReportTypesCmd.Cmd, "retrieve", "/v1/reporting/report_types/{report_type}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "create", "/v1/terminal/configurations", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "delete", "/v1/terminal/configurations/{configuration}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "list", "/v1/terminal/configurations", http.MethodGet, map[string]resource.Flag{
+		"ending_before":      {Type: "string"},
+		"is_account_default": {Type: "boolean"},
+		"limit":              {Type: "integer"},
+		"starting_after":     {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "retrieve", "/v1/terminal/configurations/{configuration}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalConfigurationsCmd.Cmd, "update", "/v1/terminal/configurations/{configuration}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalConnectionTokensCmd.Cmd, "create", "/v1/terminal/connection_tokens", http.MethodPost, map[string]resource.Flag{
+		"location": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "create", "/v1/terminal/locations", http.MethodPost, map[string]resource.Flag{
+		"configuration_overrides": {Type: "string"},
+		"display_name":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "delete", "/v1/terminal/locations/{location}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "list", "/v1/terminal/locations", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "retrieve", "/v1/terminal/locations/{location}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalLocationsCmd.Cmd, "update", "/v1/terminal/locations/{location}", http.MethodPost, map[string]resource.Flag{
+		"configuration_overrides": {Type: "string"},
+		"display_name":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "cancel_action", "/v1/terminal/readers/{reader}/cancel_action", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "create", "/v1/terminal/readers", http.MethodPost, map[string]resource.Flag{
+		"label":             {Type: "string"},
+		"location":          {Type: "string"},
+		"registration_code": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "delete", "/v1/terminal/readers/{reader}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "list", "/v1/terminal/readers", http.MethodGet, map[string]resource.Flag{
+		"device_type":    {Type: "string"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"location":       {Type: "string"},
+		"starting_after": {Type: "string"},
+		"status":         {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "process_payment_intent", "/v1/terminal/readers/{reader}/process_payment_intent", http.MethodPost, map[string]resource.Flag{
+		"payment_intent": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "process_setup_intent", "/v1/terminal/readers/{reader}/process_setup_intent", http.MethodPost, map[string]resource.Flag{
+		"customer_consent_collected": {Type: "boolean"},
+		"setup_intent":               {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "retrieve", "/v1/terminal/readers/{reader}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "set_reader_display", "/v1/terminal/readers/{reader}/set_reader_display", http.MethodPost, map[string]resource.Flag{
+		"type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersCmd.Cmd, "update", "/v1/terminal/readers/{reader}", http.MethodPost, map[string]resource.Flag{
+		"label": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTerminalReadersTestHelpersCmd.Cmd, "present_payment_method", "/v1/test_helpers/terminal/readers/{reader}/present_payment_method", http.MethodPost, map[string]resource.Flag{
+		"type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersCustomersCmd.Cmd, "fund_cash_balance", "/v1/test_helpers/customers/{customer}/fund_cash_balance", http.MethodPost, map[string]resource.Flag{
+		"amount":    {Type: "integer"},
+		"currency":  {Type: "string"},
+		"reference": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "deliver_card", "/v1/test_helpers/issuing/cards/{card}/shipping/deliver", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "fail_card", "/v1/test_helpers/issuing/cards/{card}/shipping/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "return_card", "/v1/test_helpers/issuing/cards/{card}/shipping/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersIssuingCardsCmd.Cmd, "ship_card", "/v1/test_helpers/issuing/cards/{card}/shipping/ship", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersRefundsCmd.Cmd, "expire", "/v1/test_helpers/refunds/{refund}/expire", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTerminalReadersCmd.Cmd, "present_payment_method", "/v1/test_helpers/terminal/readers/{reader}/present_payment_method", http.MethodPost, map[string]resource.Flag{
+		"type": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "advance", "/v1/test_helpers/test_clocks/{test_clock}/advance", http.MethodPost, map[string]resource.Flag{
+		"frozen_time": {Type: "integer"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "create", "/v1/test_helpers/test_clocks", http.MethodPost, map[string]resource.Flag{
+		"frozen_time": {Type: "integer"},
+		"name":        {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "delete", "/v1/test_helpers/test_clocks/{test_clock}", http.MethodDelete, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "list", "/v1/test_helpers/test_clocks", http.MethodGet, map[string]resource.Flag{
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersTestClocksCmd.Cmd, "retrieve", "/v1/test_helpers/test_clocks/{test_clock}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "fail", "/v1/test_helpers/treasury/inbound_transfers/{id}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "return_inbound_transfer", "/v1/test_helpers/treasury/inbound_transfers/{id}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryInboundTransfersCmd.Cmd, "succeed", "/v1/test_helpers/treasury/inbound_transfers/{id}/succeed", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_payments/{id}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_payments/{id}/post", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundPaymentsCmd.Cmd, "return_outbound_payment", "/v1/test_helpers/treasury/outbound_payments/{id}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/post", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryOutboundTransfersCmd.Cmd, "return_outbound_transfer", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryReceivedCreditsCmd.Cmd, "create", "/v1/test_helpers/treasury/received_credits", http.MethodPost, map[string]resource.Flag{
+		"amount":            {Type: "integer"},
+		"currency":          {Type: "string"},
+		"description":       {Type: "string"},
+		"financial_account": {Type: "string"},
+		"network":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTestHelpersTreasuryReceivedDebitsCmd.Cmd, "create", "/v1/test_helpers/treasury/received_debits", http.MethodPost, map[string]resource.Flag{
+		"amount":            {Type: "integer"},
+		"currency":          {Type: "string"},
+		"description":       {Type: "string"},
+		"financial_account": {Type: "string"},
+		"network":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "create", "/v1/treasury/credit_reversals", http.MethodPost, map[string]resource.Flag{
+		"received_credit": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "list", "/v1/treasury/credit_reversals", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"received_credit":   {Type: "string"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryCreditReversalsCmd.Cmd, "retrieve", "/v1/treasury/credit_reversals/{credit_reversal}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "create", "/v1/treasury/debit_reversals", http.MethodPost, map[string]resource.Flag{
+		"received_debit": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "list", "/v1/treasury/debit_reversals", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"received_debit":    {Type: "string"},
+		"resolution":        {Type: "string"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryDebitReversalsCmd.Cmd, "retrieve", "/v1/treasury/debit_reversals/{debit_reversal}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "create", "/v1/treasury/financial_accounts", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "list", "/v1/treasury/financial_accounts", http.MethodGet, map[string]resource.Flag{
+		"created":        {Type: "integer"},
+		"ending_before":  {Type: "string"},
+		"limit":          {Type: "integer"},
+		"starting_after": {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "retrieve", "/v1/treasury/financial_accounts/{financial_account}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "retrieve_features", "/v1/treasury/financial_accounts/{financial_account}/features", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "update", "/v1/treasury/financial_accounts/{financial_account}", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryFinancialAccountsCmd.Cmd, "update_features", "/v1/treasury/financial_accounts/{financial_account}/features", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "cancel", "/v1/treasury/inbound_transfers/{inbound_transfer}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "create", "/v1/treasury/inbound_transfers", http.MethodPost, map[string]resource.Flag{
+		"amount":                {Type: "integer"},
+		"currency":              {Type: "string"},
+		"description":           {Type: "string"},
+		"financial_account":     {Type: "string"},
+		"origin_payment_method": {Type: "string"},
+		"statement_descriptor":  {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "list", "/v1/treasury/inbound_transfers", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersCmd.Cmd, "retrieve", "/v1/treasury/inbound_transfers/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/inbound_transfers/{id}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "return_inbound_transfer", "/v1/test_helpers/treasury/inbound_transfers/{id}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryInboundTransfersTestHelpersCmd.Cmd, "succeed", "/v1/test_helpers/treasury/inbound_transfers/{id}/succeed", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "cancel", "/v1/treasury/outbound_payments/{id}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "create", "/v1/treasury/outbound_payments", http.MethodPost, map[string]resource.Flag{
+		"amount":                     {Type: "integer"},
+		"currency":                   {Type: "string"},
+		"customer":                   {Type: "string"},
+		"description":                {Type: "string"},
+		"destination_payment_method": {Type: "string"},
+		"financial_account":          {Type: "string"},
+		"statement_descriptor":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "list", "/v1/treasury/outbound_payments", http.MethodGet, map[string]resource.Flag{
+		"customer":          {Type: "string"},
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsCmd.Cmd, "retrieve", "/v1/treasury/outbound_payments/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_payments/{id}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_payments/{id}/post", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundPaymentsTestHelpersCmd.Cmd, "return_outbound_payment", "/v1/test_helpers/treasury/outbound_payments/{id}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "cancel", "/v1/treasury/outbound_transfers/{outbound_transfer}/cancel", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "create", "/v1/treasury/outbound_transfers", http.MethodPost, map[string]resource.Flag{
+		"amount":                     {Type: "integer"},
+		"currency":                   {Type: "string"},
+		"description":                {Type: "string"},
+		"destination_payment_method": {Type: "string"},
+		"financial_account":          {Type: "string"},
+		"statement_descriptor":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "list", "/v1/treasury/outbound_transfers", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersCmd.Cmd, "retrieve", "/v1/treasury/outbound_transfers/{outbound_transfer}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "fail", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/fail", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "post", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/post", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryOutboundTransfersTestHelpersCmd.Cmd, "return_outbound_transfer", "/v1/test_helpers/treasury/outbound_transfers/{outbound_transfer}/return", http.MethodPost, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedCreditsCmd.Cmd, "list", "/v1/treasury/received_credits", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedCreditsCmd.Cmd, "retrieve", "/v1/treasury/received_credits/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedCreditsTestHelpersCmd.Cmd, "create", "/v1/test_helpers/treasury/received_credits", http.MethodPost, map[string]resource.Flag{
+		"amount":            {Type: "integer"},
+		"currency":          {Type: "string"},
+		"description":       {Type: "string"},
+		"financial_account": {Type: "string"},
+		"network":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedDebitsCmd.Cmd, "list", "/v1/treasury/received_debits", http.MethodGet, map[string]resource.Flag{
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedDebitsCmd.Cmd, "retrieve", "/v1/treasury/received_debits/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryReceivedDebitsTestHelpersCmd.Cmd, "create", "/v1/test_helpers/treasury/received_debits", http.MethodPost, map[string]resource.Flag{
+		"amount":            {Type: "integer"},
+		"currency":          {Type: "string"},
+		"description":       {Type: "string"},
+		"financial_account": {Type: "string"},
+		"network":           {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryTransactionEntrysCmd.Cmd, "list", "/v1/treasury/transaction_entries", http.MethodGet, map[string]resource.Flag{
+		"created":           {Type: "integer"},
+		"effective_at":      {Type: "integer"},
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"order_by":          {Type: "string"},
+		"starting_after":    {Type: "string"},
+		"transaction":       {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryTransactionEntrysCmd.Cmd, "retrieve", "/v1/treasury/transaction_entries/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
+	resource.NewOperationCmd(rTreasuryTransactionsCmd.Cmd, "list", "/v1/treasury/transactions", http.MethodGet, map[string]resource.Flag{
+		"created":           {Type: "integer"},
+		"ending_before":     {Type: "string"},
+		"financial_account": {Type: "string"},
+		"limit":             {Type: "integer"},
+		"order_by":          {Type: "string"},
+		"starting_after":    {Type: "string"},
+		"status":            {Type: "string"},
+	}, &Config)
+	resource.NewOperationCmd(rTreasuryTransactionsCmd.Cmd, "retrieve", "/v1/treasury/transactions/{id}", http.MethodGet, map[string]resource.Flag{}, &Config)
 }
@@ -1,5 +1,6 @@
 //go:generate go run ../gen/gen_resources_cmds.go
 //go:generate go run ../gen/gen_events_list.go
+//go:generate go run ../gen/gen_fixtures.go
 
 package cmd
 
@@ -60,6 +61,12 @@ var rootCmd = &cobra.Command{
 		telemetryMetadata.SetMerchant(merchant)
 		telemetryMetadata.SetUserAgent(useragent.GetEncodedUserAgent())
 
+		rps, err := Config.Profile.GetRequestsPerSecond()
+		if err != nil {
+			log.Warn(err)
+		}
+		stripe.SetDefaultRateLimit(rps)
+
 		// plugins send their own telemetry due to having richer context than the CLI does
 		if !plugins.IsPluginCommand(cmd) {
 			// record command invocation
@@ -146,9 +153,12 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&Config.Profile.DeviceName, "device-name", "", "device name")
 	rootCmd.PersistentFlags().StringVar(&Config.LogLevel, "log-level", "info", "log level (debug, info, trace, warn, error)")
 	rootCmd.PersistentFlags().StringVarP(&Config.Profile.ProfileName, "project-name", "p", "default", "the project name to read from for config")
+	rootCmd.PersistentFlags().Float64("rps", 0, "Maximum number of API requests per second (default is the profile's requests_per_second, or no limit)")
 	rootCmd.Flags().BoolP("version", "v", false, "Get the version of the Stripe CLI")
 
 	viper.BindPFlag("color", rootCmd.PersistentFlags().Lookup("color"))
+	viper.BindPFlag(config.RequestsPerSecondName, rootCmd.PersistentFlags().Lookup("rps"))
+	rootCmd.RegisterFlagCompletionFunc("project-name", completeProfileNames) // #nosec G104
 
 	rootCmd.AddCommand(newCompletionCmd().cmd)
 	rootCmd.AddCommand(newConfigCmd().cmd)
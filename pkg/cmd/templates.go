@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -38,24 +37,15 @@ func WrappedLocalFlagUsages(cmd *cobra.Command) string {
 // commands to set values for request parameters. The string is wrapped to the
 // terminal's width.
 func WrappedRequestParamsFlagUsages(cmd *cobra.Command) string {
-	var sb strings.Builder
-
-	// We're cheating a little bit in thie method: we're not actually wrapping
-	// anything, just printing out the flag names and assuming that no name
-	// will be long enough to go over the terminal's width.
-	// We do this instead of using pflag's `FlagUsagesWrapped` function because
-	// we don't want to print the types (all request parameters flags are
-	// defined as strings in the CLI, but it would be confusing to print that
-	// out as a lot of them are not strings in the API).
-	// If/when we do add help strings for request parameters flags, we'll have
-	// to do actual wrapping.
+	requestParamsFlags := pflag.NewFlagSet("request", pflag.ExitOnError)
+
 	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
 		if _, ok := flag.Annotations["request"]; ok {
-			sb.WriteString(fmt.Sprintf("      --%s\n", flag.Name))
+			requestParamsFlags.AddFlag(flag)
 		}
 	})
 
-	return sb.String()
+	return requestParamsFlags.FlagUsagesWrapped(getTerminalWidth())
 }
 
 // WrappedNonRequestParamsFlagUsages returns a string containing the usage
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
@@ -24,6 +26,9 @@ type triggerCmd struct {
 	add           []string
 	remove        []string
 	raw           string
+	scenario      string
+	wait          bool
+	waitTimeout   time.Duration
 	apiBaseURL    string
 }
 
@@ -31,24 +36,23 @@ func newTriggerCmd() *triggerCmd {
 	tc := &triggerCmd{}
 	tc.fs = afero.NewOsFs()
 	tc.cmd = &cobra.Command{
-		Use:       "trigger <event>",
-		Args:      validators.MaximumNArgs(1),
-		ValidArgs: fixtures.EventNames(),
-		Short:     "Trigger test webhook events",
-		Long: fmt.Sprintf(`Trigger specific webhook events to be sent. Webhooks events created through
-the trigger command will also create all necessary side-effect events that are
-needed to create the triggered event as well as the corresponding API objects.
-
-%s
-%s
-`,
-			ansi.Bold("Supported events:"),
-			fixtures.EventList(),
-		),
-		Example: `stripe trigger payment_intent.created`,
-		RunE:    tc.runTriggerCmd,
+		Use:               "trigger <event>",
+		Args:              validators.MaximumNArgs(1),
+		ValidArgsFunction: completeTriggerEvents,
+		Short:             "Trigger test webhook events",
+		Example: `stripe trigger payment_intent.created
+  stripe trigger --scenario churn.yaml`,
+		RunE: tc.runTriggerCmd,
 	}
 
+	// The list of events includes the user-defined triggers, which are only
+	// looked up when the help is shown
+	defaultHelp := tc.cmd.HelpFunc()
+	tc.cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Long = triggerLongHelp()
+		defaultHelp(cmd, args)
+	})
+
 	tc.cmd.Flags().StringVar(&tc.stripeAccount, "stripe-account", "", "Set a header identifying the connected account")
 	tc.cmd.Flags().StringArrayVar(&tc.skip, "skip", []string{}, "Skip specific steps in the trigger")
 	tc.cmd.Flags().StringArrayVar(&tc.override, "override", []string{}, "Override params in the trigger")
@@ -56,6 +60,9 @@ needed to create the triggered event as well as the corresponding API objects.
 	tc.cmd.Flags().StringArrayVar(&tc.remove, "remove", []string{}, "Remove params from the trigger")
 	tc.cmd.Flags().StringVar(&tc.raw, "raw", "", "Raw fixture in string format to replace all default fixtures")
 	tc.cmd.Flags().StringVar(&tc.apiVersion, "api-version", "", "Specify API version for trigger")
+	tc.cmd.Flags().StringVar(&tc.scenario, "scenario", "", "Run a scenario file chaining multiple triggers and fixtures")
+	tc.cmd.Flags().BoolVar(&tc.wait, "wait", false, "Wait for the webhook events produced by the trigger and print them")
+	tc.cmd.Flags().DurationVar(&tc.waitTimeout, "wait-timeout", 30*time.Second, "How long to wait for webhook events when using --wait")
 
 	// Hidden configuration flags, useful for dev/debugging
 	tc.cmd.Flags().StringVar(&tc.apiBaseURL, "api-base", stripe.DefaultAPIBaseURL, "Sets the API base URL")
@@ -64,10 +71,29 @@ needed to create the triggered event as well as the corresponding API objects.
 	return tc
 }
 
+func triggerLongHelp() string {
+	return fmt.Sprintf(`Trigger specific webhook events to be sent. Webhooks events created through
+the trigger command will also create all necessary side-effect events that are
+needed to create the triggered event as well as the corresponding API objects.
+
+Custom triggers are discovered from fixture files (JSON or YAML) in the
+%s directory of your project and in the triggers directory of
+your config folder. They are named after their file and take precedence over
+the built-in triggers.
+
+%s
+%s
+`,
+		fixtures.ProjectTriggersDir,
+		ansi.Bold("Supported events:"),
+		fixtures.EventList(),
+	)
+}
+
 func (tc *triggerCmd) runTriggerCmd(cmd *cobra.Command, args []string) error {
 	version.CheckLatestVersion()
 
-	if len(args) == 0 {
+	if len(args) == 0 && tc.scenario == "" {
 		cmd.Help()
 
 		return nil
@@ -78,8 +104,26 @@ func (tc *triggerCmd) runTriggerCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if tc.scenario != "" {
+		if len(args) > 0 {
+			return fmt.Errorf("an event cannot be triggered together with --scenario")
+		}
+
+		_, err = fixtures.TriggerScenario(cmd.Context(), tc.scenario, tc.stripeAccount, tc.apiBaseURL, apiKey, tc.apiVersion)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("Scenario succeeded! Check dashboard for event details.")
+		return nil
+	}
+
 	event := args[0]
 
+	if tc.wait {
+		return tc.triggerAndWait(cmd, event, apiKey)
+	}
+
 	_, err = fixtures.Trigger(cmd.Context(), event, tc.stripeAccount, tc.apiBaseURL, apiKey, tc.skip, tc.override, tc.add, tc.remove, tc.raw, tc.apiVersion)
 	if err != nil {
 		return err
@@ -88,3 +132,24 @@ func (tc *triggerCmd) runTriggerCmd(cmd *cobra.Command, args []string) error {
 	fmt.Println("Trigger succeeded! Check dashboard for event details.")
 	return nil
 }
+
+func (tc *triggerCmd) triggerAndWait(cmd *cobra.Command, event string, apiKey string) error {
+	deviceName, err := Config.Profile.GetDeviceName()
+	if err != nil {
+		return err
+	}
+
+	_, events, err := fixtures.TriggerAndWait(cmd.Context(), event, tc.stripeAccount, tc.apiBaseURL, apiKey, tc.skip, tc.override, tc.add, tc.remove, tc.raw, tc.apiVersion, deviceName, tc.waitTimeout)
+
+	color := ansi.Color(os.Stdout)
+	for _, evt := range events {
+		fmt.Printf("  --> %s [%s]\n", ansi.Linkify(ansi.Bold(evt.Type), evt.URLForEventType(), os.Stdout), ansi.Linkify(evt.ID, evt.URLForEventID(), os.Stdout))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Trigger succeeded! %s\n", color.Faint(fmt.Sprintf("Received %d related event(s).", len(events))))
+	return nil
+}
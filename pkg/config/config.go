@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -52,6 +53,22 @@ type Config struct {
 	InstalledPlugins []string
 }
 
+// initialized is the config of the running CLI, set when it's initialized
+var initialized *Config
+
+// Folder returns the folder of the profiles file of the running CLI, where
+// the CLI also keeps its other files such as user-defined triggers and
+// caches: the folder of the file given with --config, or the config folder.
+// It's resolved when called, so that it follows the flags of the command.
+func Folder() string {
+	if initialized != nil && initialized.ProfilesFile != "" {
+		return filepath.Dir(initialized.ProfilesFile)
+	}
+
+	c := Config{}
+	return c.GetConfigFolder(os.Getenv("XDG_CONFIG_HOME"))
+}
+
 // GetProfile returns the Profile of the config
 func (c *Config) GetProfile() *Profile {
 	return &c.Profile
@@ -85,6 +102,8 @@ func (c *Config) GetConfigFolder(xdgPath string) string {
 
 // InitConfig reads in profiles file and ENV variables if set.
 func (c *Config) InitConfig() {
+	initialized = c
+
 	logFormatter := &prefixed.TextFormatter{
 		FullTimestamp:   true,
 		TimestampFormat: time.RFC1123,
@@ -232,6 +251,21 @@ func (c *Config) GetInstalledPlugins() []string {
 	return runtimeViper.GetStringSlice("installed_plugins")
 }
 
+// GetProfileNames returns the names of the profiles of the config file,
+// sorted
+func (c *Config) GetProfileNames() []string {
+	names := []string{}
+
+	for field, value := range viper.AllSettings() {
+		if isProfile(value) {
+			names = append(names, field)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
 // RemoveProfile removes the profile whose name matches the provided
 // profileName from the config file.
 func (c *Config) RemoveProfile(profileName string) error {
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -18,3 +19,31 @@ func TestRemoveKey(t *testing.T) {
 	require.EqualValues(t, []string{"stay"}, nv.AllKeys())
 	require.ElementsMatch(t, []string{"stay", "remove"}, v.AllKeys())
 }
+
+func TestGetProfileNames(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	viper.Set("color", "auto")
+	viper.Set("default", map[string]interface{}{"test_mode_api_key": "sk_test_123"})
+	viper.Set("acme", map[string]interface{}{"test_mode_api_key": "sk_test_456"})
+
+	c := &Config{}
+	require.Equal(t, []string{"acme", "default"}, c.GetProfileNames())
+}
+
+func TestFolder(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	defer func() { initialized = nil }()
+
+	initialized = nil
+	require.Equal(t, filepath.Join("/xdg", "stripe"), Folder())
+
+	// The folder follows the profiles file given with --config
+	c := &Config{ProfilesFile: filepath.Join("/custom", "stripe.toml")}
+	initialized = c
+	require.Equal(t, "/custom", Folder())
+
+	c.ProfilesFile = filepath.Join("/other", "stripe.toml")
+	require.Equal(t, "/other", Folder())
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -38,6 +39,7 @@ const (
 	LiveModeAPIKeyName         = "live_mode_api_key"
 	LiveModePubKeyName         = "live_mode_pub_key"
 	LiveModeKeyExpiresAtName   = "live_mode_key_expires_at"
+	RequestsPerSecondName      = "requests_per_second"
 )
 
 // CreateProfile creates a profile when logging in
@@ -71,6 +73,26 @@ func (p *Profile) GetColor() (string, error) {
 	}
 }
 
+// GetRequestsPerSecond returns the maximum number of API requests per
+// second, from the `--rps` flag or the profile. Zero means no limit.
+func (p *Profile) GetRequestsPerSecond() (float64, error) {
+	value := viper.GetString(RequestsPerSecondName)
+	if value == "" || value == "0" {
+		value = viper.GetString(p.GetConfigField(RequestsPerSecondName))
+	}
+
+	if value == "" {
+		return 0, nil
+	}
+
+	rps, err := strconv.ParseFloat(value, 64)
+	if err != nil || rps < 0 {
+		return 0, fmt.Errorf("%s value not supported: %s", RequestsPerSecondName, value)
+	}
+
+	return rps, nil
+}
+
 // GetDeviceName returns the configured device name
 func (p *Profile) GetDeviceName() (string, error) {
 	if os.Getenv("STRIPE_DEVICE_NAME") != "" {
@@ -112,3 +112,27 @@ func helperLoadBytes(t *testing.T, name string) []byte {
 func cleanUp(file string) {
 	os.Remove(file)
 }
+
+func TestGetRequestsPerSecond(t *testing.T) {
+	p := Profile{ProfileName: "rps_tests"}
+	defer viper.Reset()
+
+	rps, err := p.GetRequestsPerSecond()
+	require.NoError(t, err)
+	require.Equal(t, 0.0, rps)
+
+	viper.Set(p.GetConfigField(RequestsPerSecondName), "25")
+	rps, err = p.GetRequestsPerSecond()
+	require.NoError(t, err)
+	require.Equal(t, 25.0, rps)
+
+	// The --rps flag takes precedence over the profile
+	viper.Set(RequestsPerSecondName, "2.5")
+	rps, err = p.GetRequestsPerSecond()
+	require.NoError(t, err)
+	require.Equal(t, 2.5, rps)
+
+	viper.Set(RequestsPerSecondName, "fast")
+	_, err = p.GetRequestsPerSecond()
+	require.EqualError(t, err, "requests_per_second value not supported: fast")
+}
@@ -0,0 +1,201 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/ansi"
+)
+
+// Assertions let a fixture step verify the response it received. Each
+// step can declare an `assert` list of expressions in the shape:
+//
+//	<gjson path> <operator> <expected value>
+//
+// for example:
+//
+//	"assert": [
+//	  "status == \"requires_action\"",
+//	  "amount_received == 1500",
+//	  "lines.data.# == 3",
+//	  "latest_charge"
+//	]
+//
+// The supported operators are ==, !=, >, >=, < and <=. The expected value
+// is a JSON literal (string, number, boolean or null) and may contain
+// fixture queries such as `${customer:id}`. An expression without an
+// operator only checks that the path exists in the response.
+
+var assertionRegex = regexp.MustCompile(`^\s*(\S+?)\s*(==|!=|>=|<=|>|<)\s*(.+?)\s*$`)
+
+type assertion struct {
+	Expression string
+	Path       string
+	Operator   string
+	Expected   string
+}
+
+// AssertionFailure describes a single assertion that did not hold
+type AssertionFailure struct {
+	Fixture    string
+	Expression string
+	Actual     string
+}
+
+// AssertionError is returned by Execute when one or more assertions
+// declared in the fixture failed
+type AssertionError struct {
+	Failures []AssertionFailure
+}
+
+func (e AssertionError) Error() string {
+	lines := []string{fmt.Sprintf("%d fixture assertion(s) failed:", len(e.Failures))}
+	for _, failure := range e.Failures {
+		lines = append(lines, fmt.Sprintf("  - %s: %s (actual: %s)", failure.Fixture, failure.Expression, failure.Actual))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func parseAssertion(expression string) (assertion, error) {
+	if matches := assertionRegex.FindStringSubmatch(expression); matches != nil {
+		return assertion{
+			Expression: expression,
+			Path:       matches[1],
+			Operator:   matches[2],
+			Expected:   matches[3],
+		}, nil
+	}
+
+	path := strings.TrimSpace(expression)
+	if path == "" || strings.ContainsAny(path, " \t") {
+		return assertion{}, fmt.Errorf("invalid assertion: %s", expression)
+	}
+
+	return assertion{Expression: expression, Path: path}, nil
+}
+
+// validateAssertions parses all assertions of the fixture so that syntax
+// errors surface before any request is made
+func (fxt *Fixture) validateAssertions() error {
+	for _, data := range fxt.fixture.Fixtures {
+		for _, expression := range data.Assert {
+			if _, err := parseAssertion(expression); err != nil {
+				return fmt.Errorf("%s: %w", data.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkAssertions evaluates the assertions of a fixture step against its
+// response, printing the result of each and returning the failures
+func (fxt *Fixture) checkAssertions(data fixture, resp gjson.Result) ([]AssertionFailure, error) {
+	var failures []AssertionFailure
+	color := ansi.Color(fxt.progress())
+
+	for _, expression := range data.Assert {
+		a, err := parseAssertion(expression)
+		if err != nil {
+			return nil, err
+		}
+
+		ok, actual, err := fxt.evaluateAssertion(a, resp)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			fmt.Fprintf(fxt.progress(), "  %s %s\n", color.Green("✔"), expression)
+			continue
+		}
+
+		fmt.Fprintf(fxt.progress(), "  %s %s (actual: %s)\n", color.Red("✘"), expression, actual)
+		failures = append(failures, AssertionFailure{
+			Fixture:    data.Name,
+			Expression: expression,
+			Actual:     actual,
+		})
+	}
+
+	return failures, nil
+}
+
+func (fxt *Fixture) evaluateAssertion(a assertion, resp gjson.Result) (bool, string, error) {
+	actual := resp.Get(a.Path)
+	actualString := describeResult(actual)
+
+	if a.Operator == "" {
+		return actual.Exists(), actualString, nil
+	}
+
+	expectedRaw, err := fxt.parseQuery(a.Expected)
+	if err != nil {
+		return false, actualString, err
+	}
+	expected := parseExpectedValue(expectedRaw)
+
+	switch a.Operator {
+	case "==":
+		return resultsEqual(actual, expected), actualString, nil
+	case "!=":
+		return !resultsEqual(actual, expected), actualString, nil
+	}
+
+	if expected.Type != gjson.Number {
+		return false, actualString, fmt.Errorf("invalid assertion: %s requires a number, got %s", a.Operator, a.Expected)
+	}
+	if actual.Type != gjson.Number {
+		return false, actualString, nil
+	}
+
+	switch a.Operator {
+	case ">":
+		return actual.Float() > expected.Float(), actualString, nil
+	case ">=":
+		return actual.Float() >= expected.Float(), actualString, nil
+	case "<":
+		return actual.Float() < expected.Float(), actualString, nil
+	default:
+		return actual.Float() <= expected.Float(), actualString, nil
+	}
+}
+
+// parseExpectedValue reads the expected value as a JSON literal. Values
+// that are not valid JSON, like an unquoted word, are treated as strings.
+func parseExpectedValue(raw string) gjson.Result {
+	if json.Valid([]byte(raw)) {
+		return gjson.Parse(raw)
+	}
+
+	quoted, _ := json.Marshal(raw)
+	return gjson.ParseBytes(quoted)
+}
+
+func resultsEqual(actual, expected gjson.Result) bool {
+	switch expected.Type {
+	case gjson.Null:
+		return !actual.Exists() || actual.Type == gjson.Null
+	case gjson.Number:
+		return actual.Type == gjson.Number && actual.Float() == expected.Float()
+	case gjson.True, gjson.False:
+		return actual.Type == expected.Type
+	case gjson.String:
+		return actual.Type == gjson.String && actual.Str == expected.Str
+	default:
+		return actual.Raw == expected.Raw
+	}
+}
+
+func describeResult(result gjson.Result) string {
+	if !result.Exists() {
+		return "<missing>"
+	}
+
+	return result.Raw
+}
@@ -0,0 +1,259 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/proxy"
+	"github.com/stripe/stripe-cli/pkg/websocket"
+)
+
+// The functions in this file open a listen session while a trigger runs
+// and collect the webhook events that the trigger produced. Events are
+// correlated with the trigger through the objects its fixtures created:
+// an event is related when its `data.object` is one of those objects, one
+// of the objects they reference (for example the charge of a payment
+// intent), or references one of them anywhere (for example a charge
+// referencing the payment intent created by the fixture).
+//
+// Events are buffered from the moment the session is ready, without limit,
+// so that none is lost before or while they are collected.
+
+// eventSettleDelay is how long the collector keeps listening for related
+// events once the expected event was received
+var eventSettleDelay = 2 * time.Second
+
+const webhooksWebSocketFeature = "webhooks"
+
+// EventCollector receives the webhook events of a listen session
+type EventCollector struct {
+	ready     chan struct{}
+	readyOnce sync.Once
+	errors    chan error
+
+	mu       sync.Mutex
+	received []proxy.StripeEvent
+	// notify is signaled when an event is received
+	notify chan struct{}
+}
+
+// ListenForEvents opens a listen session and returns once the session is
+// ready to receive events. The session is closed when ctx is done.
+func ListenForEvents(ctx context.Context, deviceName, apiKey, apiBaseURL string) (*EventCollector, error) {
+	outCh := make(chan websocket.IElement)
+
+	p, err := proxy.Init(ctx, &proxy.Config{
+		DeviceName:       deviceName,
+		Key:              apiKey,
+		APIBaseURL:       apiBaseURL,
+		WebSocketFeature: webhooksWebSocketFeature,
+		OutCh:            outCh,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go p.Run(ctx)
+
+	collector := newEventCollector(outCh)
+
+	select {
+	case <-collector.ready:
+		return collector, nil
+	case err := <-collector.errors:
+		return nil, err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newEventCollector(outCh <-chan websocket.IElement) *EventCollector {
+	collector := &EventCollector{
+		ready:  make(chan struct{}),
+		errors: make(chan error, 1),
+		notify: make(chan struct{}, 1),
+	}
+
+	visitor := &websocket.Visitor{
+		VisitError: func(ee websocket.ErrorElement) error {
+			select {
+			case collector.errors <- ee.Error:
+			default:
+			}
+			return nil
+		},
+		VisitStatus: func(se websocket.StateElement) error {
+			// The session reports being ready again after reconnecting
+			if se.State == websocket.Ready {
+				collector.readyOnce.Do(func() { close(collector.ready) })
+			}
+			return nil
+		},
+		VisitData: func(de websocket.DataElement) error {
+			if evt, ok := de.Data.(proxy.StripeEvent); ok {
+				collector.mu.Lock()
+				collector.received = append(collector.received, evt)
+				collector.mu.Unlock()
+
+				select {
+				case collector.notify <- struct{}{}:
+				default:
+					// The collector was already notified
+				}
+			}
+			return nil
+		},
+	}
+
+	go func() {
+		for el := range outCh {
+			el.Accept(visitor)
+		}
+	}()
+
+	return collector
+}
+
+// Collect waits for the events related to the given object IDs. When
+// expectedType is set, it returns shortly after an event of that type is
+// received; otherwise it returns shortly after the first related event.
+// If that does not happen before the timeout, the events received so far
+// are returned along with an error.
+func (c *EventCollector) Collect(ctx context.Context, objectIDs []string, expectedType string, timeout time.Duration) ([]proxy.StripeEvent, error) {
+	ids := make(map[string]bool, len(objectIDs))
+	for _, id := range objectIDs {
+		ids[id] = true
+	}
+
+	var collected []proxy.StripeEvent
+	done := false
+	next := 0
+
+	timeoutCh := time.After(timeout)
+	var settleCh <-chan time.Time
+
+	for {
+		for _, evt := range c.receivedSince(&next) {
+			if !isRelatedEvent(evt, ids) {
+				continue
+			}
+
+			collected = append(collected, evt)
+			if expectedType == "" || evt.Type == expectedType {
+				done = true
+			}
+			if done {
+				settleCh = time.After(eventSettleDelay)
+			}
+		}
+
+		select {
+		case <-c.notify:
+		case <-settleCh:
+			return collected, nil
+		case <-timeoutCh:
+			if done {
+				return collected, nil
+			}
+
+			waitingFor := "events"
+			if expectedType != "" {
+				waitingFor = expectedType
+			}
+
+			return collected, fmt.Errorf("timed out after %s waiting for %s", timeout, waitingFor)
+		case err := <-c.errors:
+			return collected, err
+		case <-ctx.Done():
+			return collected, ctx.Err()
+		}
+	}
+}
+
+// receivedSince returns the events received after the first *next ones,
+// and moves *next past them
+func (c *EventCollector) receivedSince(next *int) []proxy.StripeEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	events := c.received[*next:]
+	*next = len(c.received)
+
+	return events
+}
+
+// isRelatedEvent reports whether the object of the event is one of the
+// given objects or references one of them, at any depth
+func isRelatedEvent(evt proxy.StripeEvent, ids map[string]bool) bool {
+	return referencesID(evt.Data["object"], ids)
+}
+
+func referencesID(value interface{}, ids map[string]bool) bool {
+	switch v := value.(type) {
+	case string:
+		return ids[v]
+	case map[string]interface{}:
+		for _, item := range v {
+			if referencesID(item, ids) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if referencesID(item, ids) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ObjectIDs returns the IDs of the objects returned by the requests the
+// fixture executed, and of the objects they reference or embed, such as
+// the `latest_charge` of a payment intent or the items of a subscription
+func (fxt *Fixture) ObjectIDs() []string {
+	var ids []string
+	seen := make(map[string]bool)
+
+	for _, data := range fxt.fixture.Fixtures {
+		if resp, ok := fxt.responses[data.Name]; ok {
+			collectObjectIDs(resp, "", seen, &ids)
+		}
+	}
+
+	return ids
+}
+
+// idRegexp matches Stripe object IDs such as `pi_3LJm2u2eZvKYlo2C0Z8yJ3fK`
+var idRegexp = regexp.MustCompile(`^[a-z]+(_[a-z]+)*_[0-9A-Za-z]{8,}$`)
+
+func collectObjectIDs(value gjson.Result, key string, seen map[string]bool, ids *[]string) {
+	switch {
+	case value.IsObject() || value.IsArray():
+		value.ForEach(func(k, v gjson.Result) bool {
+			collectObjectIDs(v, k.String(), seen, ids)
+			return true
+		})
+	case value.Type == gjson.String:
+		id := value.String()
+		// Only the `id` fields and the values that look like IDs are kept,
+		// not enum values such as `requires_payment_method`
+		if seen[id] || (key != "id" && !isObjectID(id)) {
+			return
+		}
+
+		seen[id] = true
+		*ids = append(*ids, id)
+	}
+}
+
+func isObjectID(value string) bool {
+	return idRegexp.MatchString(value) && strings.ContainsAny(value, "0123456789")
+}
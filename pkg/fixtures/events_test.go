@@ -0,0 +1,107 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/proxy"
+	"github.com/stripe/stripe-cli/pkg/websocket"
+)
+
+func stripeEvent(id, eventType string, object map[string]interface{}) websocket.DataElement {
+	return websocket.DataElement{
+		Data: proxy.StripeEvent{
+			ID:   id,
+			Type: eventType,
+			Data: map[string]interface{}{"object": object},
+		},
+	}
+}
+
+func TestEventCollectorCollect(t *testing.T) {
+	eventSettleDelay = 10 * time.Millisecond
+
+	outCh := make(chan websocket.IElement)
+	collector := newEventCollector(outCh)
+
+	go func() {
+		outCh <- websocket.StateElement{State: websocket.Ready}
+		outCh <- stripeEvent("evt_1", "customer.created", map[string]interface{}{"id": "cus_other"})
+		outCh <- stripeEvent("evt_2", "payment_intent.created", map[string]interface{}{"id": "pi_123"})
+		outCh <- stripeEvent("evt_3", "charge.succeeded", map[string]interface{}{"id": "ch_123", "payment_intent": "pi_123"})
+		outCh <- stripeEvent("evt_4", "payment_intent.succeeded", map[string]interface{}{"id": "pi_123"})
+	}()
+
+	<-collector.ready
+
+	events, err := collector.Collect(context.Background(), []string{"pi_123"}, "payment_intent.succeeded", time.Second)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, evt := range events {
+		ids = append(ids, evt.ID)
+	}
+	assert.Equal(t, []string{"evt_2", "evt_3", "evt_4"}, ids)
+}
+
+func TestEventCollectorTimeout(t *testing.T) {
+	outCh := make(chan websocket.IElement)
+	collector := newEventCollector(outCh)
+
+	go func() {
+		outCh <- stripeEvent("evt_1", "payment_intent.created", map[string]interface{}{"id": "pi_123"})
+	}()
+
+	events, err := collector.Collect(context.Background(), []string{"pi_123"}, "payment_intent.succeeded", 50*time.Millisecond)
+	require.EqualError(t, err, "timed out after 50ms waiting for payment_intent.succeeded")
+	require.Len(t, events, 1)
+}
+
+func TestEventCollectorBuffersEvents(t *testing.T) {
+	eventSettleDelay = 10 * time.Millisecond
+
+	outCh := make(chan websocket.IElement)
+	collector := newEventCollector(outCh)
+
+	// Events received before Collect is called are kept, however many there
+	// are
+	outCh <- websocket.StateElement{State: websocket.Ready}
+	for i := 0; i < 200; i++ {
+		outCh <- stripeEvent(fmt.Sprintf("evt_other_%d", i), "customer.created", map[string]interface{}{"id": "cus_other"})
+	}
+	outCh <- stripeEvent("evt_1", "invoice.created", map[string]interface{}{
+		"id": "in_123",
+		"lines": map[string]interface{}{
+			"data": []interface{}{map[string]interface{}{"id": "il_123", "subscription": "sub_123"}},
+		},
+	})
+
+	events, err := collector.Collect(context.Background(), []string{"sub_123"}, "invoice.created", time.Second)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "evt_1", events[0].ID)
+}
+
+func TestObjectIDs(t *testing.T) {
+	fxt := Fixture{
+		fixture: fixtureFile{Fixtures: []fixture{{Name: "pi"}, {Name: "sub"}}},
+		responses: map[string]gjson.Result{
+			"pi":  gjson.Parse(`{"id": "pi_3LJm2u2eZvKYlo2C", "status": "requires_confirmation", "latest_charge": "ch_3LJm2u2eZvKYlo2C"}`),
+			"sub": gjson.Parse(`{"id": "sub_1LJm2u2eZvKYlo2C", "items": {"data": [{"id": "si_M1LJm2u2eZvKYl", "price": {"id": "price_1LJm2u2eZvKYlo2C"}}]}}`),
+		},
+	}
+
+	require.Equal(t, []string{
+		"pi_3LJm2u2eZvKYlo2C",
+		"ch_3LJm2u2eZvKYlo2C",
+		"sub_1LJm2u2eZvKYlo2C",
+		"si_M1LJm2u2eZvKYl",
+		"price_1LJm2u2eZvKYlo2C",
+	}, fxt.ObjectIDs())
+}
@@ -30,8 +30,10 @@ type metaFixture struct {
 
 type fixtureFile struct {
 	Meta     metaFixture       `json:"_meta"`
+	Include  []fixtureInclude  `json:"include,omitempty"`
 	Fixtures []fixture         `json:"fixtures"`
 	Env      map[string]string `json:"env"`
+	Outputs  map[string]string `json:"outputs,omitempty"`
 }
 
 type fixture struct {
@@ -40,6 +42,23 @@ type fixture struct {
 	Path              string                 `json:"path"`
 	Method            string                 `json:"method"`
 	Params            map[string]interface{} `json:"params"`
+	Assert            []string               `json:"assert,omitempty"`
+	Wait              *fixtureWait           `json:"wait,omitempty"`
+	TestClock         *fixtureTestClock      `json:"test_clock,omitempty"`
+	Advance           *fixtureAdvance        `json:"advance,omitempty"`
+	Multipart         bool                   `json:"multipart,omitempty"`
+	// StripeAccount is the connected account the step acts as. When it is
+	// not set, the step uses the account of the fixture; an empty string
+	// makes the step act as the platform.
+	StripeAccount *string `json:"stripe_account,omitempty"`
+
+	// excludeMetadata is set on requests the CLI makes on behalf of a
+	// step, for endpoints that do not accept metadata
+	excludeMetadata bool
+
+	// dir is the directory of the fixture file that declares the step,
+	// which relative file paths are resolved against
+	dir string
 }
 
 type fixtureQuery struct {
@@ -59,11 +78,20 @@ type Fixture struct {
 	Additions     map[string]interface{}
 	Removals      map[string]interface{}
 	BaseURL       string
+	// Progress receives the lines describing the steps as they run,
+	// os.Stdout when not set
+	Progress      io.Writer
 	responses     map[string]gjson.Result
 	fixture       fixtureFile
+	run           *RunState
+	completed     map[string]bool
+	results       []StepResult
+	lastStatus    int
+	lastRequestID string
 }
 
-// NewFixtureFromFile creates a to later run steps for populating test data
+// NewFixtureFromFile creates a to later run steps for populating test data.
+// The file can be written in JSON or YAML and may include other fixture files.
 func NewFixtureFromFile(fs afero.Fs, apiKey, stripeAccount, baseURL, file string, skip, override, add, remove []string) (*Fixture, error) {
 	fxt := Fixture{
 		Fs:            fs,
@@ -74,27 +102,10 @@ func NewFixtureFromFile(fs afero.Fs, apiKey, stripeAccount, baseURL, file string
 		responses:     make(map[string]gjson.Result),
 	}
 
-	var filedata []byte
-	var err error
-
-	if _, ok := reverseMap()[file]; ok {
-		f, err := triggers.Open(file)
-		if err != nil {
-			return nil, err
-		}
+	_, embedded := reverseMap()[file]
 
-		filedata, err = io.ReadAll(f)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		filedata, err = afero.ReadFile(fxt.Fs, file)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	err = json.Unmarshal(filedata, &fxt.fixture)
+	var err error
+	fxt.fixture, err = loadFixtureFile(fs, file, embedded, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -110,10 +121,6 @@ func NewFixtureFromFile(fs afero.Fs, apiKey, stripeAccount, baseURL, file string
 		return nil, err
 	}
 
-	if fxt.fixture.Meta.Version > SupportedVersions {
-		return nil, fmt.Errorf("Fixture version not supported: %s", fmt.Sprint(fxt.fixture.Meta.Version))
-	}
-
 	return &fxt, nil
 }
 
@@ -128,13 +135,21 @@ func NewFixtureFromRawString(fs afero.Fs, apiKey, stripeAccount, baseURL, raw st
 		responses:     make(map[string]gjson.Result),
 	}
 
-	err := json.Unmarshal([]byte(raw), &fxt.fixture)
+	// Raw fixtures are JSON most of the time, but anything that is not
+	// valid JSON is given a chance to parse as YAML
+	parsed, err := unmarshalFixtureFile([]byte(raw), !json.Valid([]byte(raw)))
 	if err != nil {
 		return nil, err
 	}
 
-	if fxt.fixture.Meta.Version > SupportedVersions {
-		return nil, fmt.Errorf("Fixture version not supported: %s", fmt.Sprint(fxt.fixture.Meta.Version))
+	if parsed.Meta.Version > SupportedVersions {
+		return nil, fmt.Errorf("Fixture version not supported: %s", fmt.Sprint(parsed.Meta.Version))
+	}
+
+	// Includes in a raw fixture are resolved from the working directory
+	fxt.fixture, err = resolveIncludes(fs, parsed, ".", false, nil)
+	if err != nil {
+		return nil, err
 	}
 
 	return &fxt, nil
@@ -250,30 +265,130 @@ func (fxt *Fixture) Remove(removals []string) error {
 	return nil
 }
 
+func (fxt *Fixture) progress() io.Writer {
+	if fxt.Progress == nil {
+		return os.Stdout
+	}
+
+	return fxt.Progress
+}
+
 // Execute takes the parsed fixture file and runs through all the requests
 // defined to populate the user's account
+//
+// If any of the assertions declared by the steps fail, the remaining steps
+// still run and an AssertionError listing every failure is returned.
 func (fxt *Fixture) Execute(ctx context.Context, apiVersion string) ([]string, error) {
+	if err := fxt.validate(); err != nil {
+		return nil, err
+	}
+
+	var failures []AssertionFailure
 	requestNames := make([]string, len(fxt.fixture.Fixtures))
 	for i, data := range fxt.fixture.Fixtures {
 		if isNameIn(data.Name, fxt.Skip) {
-			fmt.Printf("Skipping fixture for: %s\n", data.Name)
+			fmt.Fprintf(fxt.progress(), "Skipping fixture for: %s\n", data.Name)
+			fxt.recordSkippedStep(data.Name)
 			continue
 		}
 
-		fmt.Printf("Setting up fixture for: %s\n", data.Name)
 		requestNames[i] = data.Name
 
-		fmt.Printf("Running fixture for: %s\n", data.Name)
-		resp, err := fxt.makeRequest(ctx, data, apiVersion)
+		if fxt.completed[data.Name] {
+			fmt.Fprintf(fxt.progress(), "Skipping completed fixture for: %s\n", data.Name)
+			fxt.recordSkippedStep(data.Name)
+			continue
+		}
+
+		fmt.Fprintf(fxt.progress(), "Setting up fixture for: %s\n", data.Name)
+
+		start := time.Now()
+		fxt.lastStatus, fxt.lastRequestID = 0, ""
+
+		fmt.Fprintf(fxt.progress(), "Running fixture for: %s\n", data.Name)
+		resp, err := fxt.executeStep(ctx, data, apiVersion)
 		if err != nil && !errWasExpected(err, data.ExpectedErrorType) {
-			return nil, err
+			return nil, fxt.failStep(data.Name, start, err)
 		}
 
 		fxt.responses[data.Name] = gjson.ParseBytes(resp)
+
+		stepFailures, err := fxt.checkAssertions(data, fxt.responses[data.Name])
+		if err != nil {
+			return nil, fxt.failStep(data.Name, start, err)
+		}
+		failures = append(failures, stepFailures...)
+
+		if len(stepFailures) > 0 {
+			fxt.recordStep(data.Name, start, AssertionError{Failures: stepFailures})
+		} else {
+			fxt.recordStep(data.Name, start, nil)
+		}
+
+		if err := fxt.saveRun(data.Name, nil); err != nil {
+			return nil, err
+		}
 	}
+
+	// Every step has run, so there is nothing left to resume even if some
+	// assertions failed
+	if err := fxt.finishRun(); err != nil {
+		return nil, err
+	}
+
+	if len(failures) > 0 {
+		return requestNames, AssertionError{Failures: failures}
+	}
+
 	return requestNames, nil
 }
 
+// failStep records the failure of a step in the report and the state of
+// the run and returns the step error
+func (fxt *Fixture) failStep(step string, start time.Time, stepErr error) error {
+	fxt.recordStep(step, start, stepErr)
+
+	if err := fxt.saveRun(step, stepErr); err != nil {
+		return err
+	}
+
+	return stepErr
+}
+
+// validate checks the configuration of the fixture steps so that errors
+// surface before any request is made
+func (fxt *Fixture) validate() error {
+	validators := []func() error{
+		fxt.validateAssertions,
+		fxt.validateWaits,
+		fxt.validateTestClocks,
+		fxt.validateMultipart,
+	}
+
+	for _, validate := range validators {
+		if err := validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// executeStep runs the request(s) of a single fixture step and returns the
+// response to store for it
+func (fxt *Fixture) executeStep(ctx context.Context, data fixture, apiVersion string) ([]byte, error) {
+	switch {
+	case data.TestClock != nil:
+		return fxt.createTestClock(ctx, data, apiVersion)
+	case data.Advance != nil:
+		return fxt.advanceTestClock(ctx, data, apiVersion)
+	case data.Wait != nil:
+		return fxt.waitFor(ctx, data, apiVersion)
+	}
+
+	return fxt.makeRequest(ctx, data, apiVersion)
+}
+
 func errWasExpected(err error, expectedErrorType string) bool {
 	if rerr, ok := err.(requests.RequestError); ok {
 		return rerr.ErrorType == expectedErrorType
@@ -294,8 +409,13 @@ func (fxt *Fixture) UpdateEnv() error {
 func (fxt *Fixture) makeRequest(ctx context.Context, data fixture, apiVersion string) ([]byte, error) {
 	var rp requests.RequestParameters
 
-	if data.Method == "post" && !fxt.fixture.Meta.ExcludeMetadata {
+	if data.Method == "post" && !fxt.fixture.Meta.ExcludeMetadata && !data.excludeMetadata && !data.Multipart {
 		now := time.Now().String()
+		if fxt.run != nil {
+			// Resumed runs must send the same params for the same
+			// idempotency keys
+			now = fxt.run.StartedAt
+		}
 		metadata := fmt.Sprintf("metadata[_created_by_fixture]=%s", now)
 		rp.AppendData([]string{metadata})
 	}
@@ -305,6 +425,7 @@ func (fxt *Fixture) makeRequest(ctx context.Context, data fixture, apiVersion st
 		SuppressOutput: true,
 		APIBaseURL:     fxt.BaseURL,
 		Parameters:     rp,
+		OnResponse:     fxt.onResponse,
 	}
 
 	path, err := fxt.parsePath(data)
@@ -313,16 +434,47 @@ func (fxt *Fixture) makeRequest(ctx context.Context, data fixture, apiVersion st
 		return make([]byte, 0), err
 	}
 
-	params, err := fxt.createParams(data.Params, apiVersion)
+	stepParams := data.Params
+	if data.Multipart {
+		stepParams, err = fxt.multipartParams(data)
+		if err != nil {
+			return make([]byte, 0), err
+		}
+		req.APIBaseURL = fxt.filesBaseURL()
+	}
 
+	stripeAccount, err := fxt.stepStripeAccount(data)
 	if err != nil {
 		return make([]byte, 0), err
 	}
 
+	params, err := fxt.createParams(stepParams, stripeAccount, apiVersion)
+
+	if err != nil {
+		return make([]byte, 0), err
+	}
+
+	if fxt.run != nil && data.Method == "post" {
+		params.SetIdempotency(fxt.idempotencyKey(data.Name))
+	}
+
+	if data.Multipart {
+		return req.MakeMultiPartRequest(ctx, fxt.APIKey, path, params, true)
+	}
+
 	return req.MakeRequest(ctx, fxt.APIKey, path, params, true)
 }
 
-func (fxt *Fixture) createParams(params interface{}, apiVersion string) (*requests.RequestParameters, error) {
+// stepStripeAccount returns the account a step acts as
+func (fxt *Fixture) stepStripeAccount(data fixture) (string, error) {
+	if data.StripeAccount == nil {
+		return fxt.StripeAccount, nil
+	}
+
+	return fxt.parseQuery(*data.StripeAccount)
+}
+
+func (fxt *Fixture) createParams(params interface{}, stripeAccount, apiVersion string) (*requests.RequestParameters, error) {
 	requestParams := requests.RequestParameters{}
 	parsed, err := fxt.parseInterface(params)
 	if err != nil {
@@ -330,7 +482,7 @@ func (fxt *Fixture) createParams(params interface{}, apiVersion string) (*reques
 	}
 	requestParams.AppendData(parsed)
 
-	requestParams.SetStripeAccount(fxt.StripeAccount)
+	requestParams.SetStripeAccount(stripeAccount)
 
 	if apiVersion != "" {
 		requestParams.SetVersion(apiVersion)
@@ -356,7 +508,7 @@ func getEnvVar(query fixtureQuery) (string, error) {
 		envValue = os.Getenv(key)
 	}
 	if envValue == "" {
-		fmt.Printf("No value for env var: %s\n", key)
+		fmt.Fprintf(os.Stderr, "No value for env var: %s\n", key)
 		return "", nil
 	}
 
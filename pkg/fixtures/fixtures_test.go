@@ -3,6 +3,7 @@ package fixtures
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/afero"
 	"github.com/tidwall/gjson"
@@ -552,3 +554,762 @@ func priceFixture() *Fixture {
 		},
 	}
 }
+
+const yamlTestFixture = `
+_meta:
+  template_version: 0
+fixtures:
+  - name: cust_bender
+    path: /v1/customers
+    method: post
+    params:
+      name: Bender Bending Rodriguez
+      address:
+        line1: 1 Planet Express St
+  - name: char_bender
+    path: /v1/charges
+    method: post
+    params:
+      customer: ${cust_bender:id}
+      amount: 100
+`
+
+const includeCommonFixture = `
+fixtures:
+  - name: customer
+    path: /v1/customers
+    method: post
+    params:
+      name: Hermes Conrad
+env:
+  CUSTOMER_ID: ${customer:id}
+`
+
+const includeTestFixture = `
+{
+	"_meta": {
+		"template_version": 0
+	},
+	"include": [
+		"common/customer.yaml",
+		{"path": "common/customer.yaml", "as": "other"}
+	],
+	"fixtures": [
+		{
+			"name": "char_bender",
+			"path": "/v1/charges",
+			"method": "post",
+			"params": {
+				"customer": "${customer.customer:id}",
+				"description": "${other.customer:id}"
+			}
+		}
+	]
+}`
+
+func TestMakeRequestWithYAMLFixture(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("Failure with request body: %s", err)
+		}
+
+		switch url := req.URL.String(); url {
+		case customersPath:
+			res.Write([]byte(`{"id": "cust_12345", "foo": "bar"}`))
+			require.True(t, strings.Contains(string(body), "address[line1]=1+Planet+Express+St"))
+		case chargePath:
+			res.Write([]byte(`{"charge": true, "id": "char_12345"}`))
+			require.True(t, strings.Contains(string(body), "customer=cust_12345"))
+			require.True(t, strings.Contains(string(body), "amount=100"))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, "test_fixture.yaml", []byte(yamlTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, "test_fixture.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, "char_12345", fxt.responses["char_bender"].Get("id").String())
+}
+
+func TestMakeRequestWithIncludes(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	customerCount := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("Failure with request body: %s", err)
+		}
+
+		switch url := req.URL.String(); url {
+		case customersPath:
+			customerCount++
+			res.Write([]byte(fmt.Sprintf(`{"id": "cust_%d"}`, customerCount)))
+		case chargePath:
+			res.Write([]byte(`{"id": "char_12345"}`))
+			require.True(t, strings.Contains(string(body), "customer=cust_1"))
+			require.True(t, strings.Contains(string(body), "description=cust_2"))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, filepath.Join("fixtures", "common", "customer.yaml"), []byte(includeCommonFixture), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join("fixtures", "charge.json"), []byte(includeTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, filepath.Join("fixtures", "charge.json"), []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	requestNames, err := fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"customer.customer", "other.customer", "char_bender"}, requestNames)
+	assert.Equal(t, "${customer.customer:id}", fxt.fixture.Env["CUSTOMER_ID"])
+}
+
+func TestExecuteWithIncludedAssertions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch url := req.URL.String(); url {
+		case customersPath:
+			res.Write([]byte(`{"id": "cust_12345"}`))
+		case "/v1/payment_intents":
+			res.Write([]byte(`{"id": "pi_12345", "customer": "cust_12345"}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+	defer ts.Close()
+
+	afero.WriteFile(fs, filepath.Join("fixtures", "common", "payment.yaml"), []byte(`
+fixtures:
+  - name: customer
+    path: /v1/customers
+    method: post
+  - name: payment_intent
+    path: /v1/payment_intents
+    method: post
+    params:
+      customer: ${customer:id}
+    assert:
+      - customer == "${customer:id}"
+`), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join("fixtures", "checkout.yaml"), []byte(`
+include:
+  - common/payment.yaml
+fixtures: []
+`), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, filepath.Join("fixtures", "checkout.yaml"), []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, []string{`customer == "${payment.customer:id}"`}, fxt.fixture.Fixtures[1].Assert)
+}
+
+func TestIncludeCycle(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "a.json", []byte(`{"include": ["b.json"], "fixtures": []}`), os.ModePerm)
+	afero.WriteFile(fs, "b.json", []byte(`{"include": ["a.json"], "fixtures": []}`), os.ModePerm)
+
+	_, err := NewFixtureFromFile(fs, apiKey, "", "", "a.json", []string{}, []string{}, []string{}, []string{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "fixture include cycle detected")
+}
+
+func TestWriteOutputs(t *testing.T) {
+	fxt := Fixture{
+		responses: map[string]gjson.Result{
+			"price":    gjson.Parse(`{"id": "price_12345"}`),
+			"customer": gjson.Parse(`{"id": "cus_12345", "name": "Leela's"}`),
+		},
+		fixture: fixtureFile{
+			Outputs: map[string]string{
+				"PRICE_ID":      "${price:id}",
+				"CUSTOMER_NAME": "${customer:name}",
+			},
+		},
+	}
+
+	var buf strings.Builder
+	require.NoError(t, fxt.WriteOutputs(&buf, OutputFormatJSON))
+	assert.JSONEq(t, `{"PRICE_ID": "price_12345", "CUSTOMER_NAME": "Leela's"}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, fxt.WriteOutputs(&buf, OutputFormatDotenv))
+	assert.Equal(t, "CUSTOMER_NAME=\"Leela's\"\nPRICE_ID=\"price_12345\"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, fxt.WriteOutputs(&buf, OutputFormatShell))
+	assert.Equal(t, "export CUSTOMER_NAME='Leela'\\''s'\nexport PRICE_ID='price_12345'\n", buf.String())
+
+	require.Error(t, fxt.WriteOutputs(&buf, "xml"))
+}
+
+const assertTestFixture = `
+fixtures:
+  - name: cust_bender
+    path: /v1/customers
+    method: post
+    assert:
+      - id
+      - name == "Bender"
+  - name: pi_bender
+    path: /v1/payment_intents
+    method: post
+    params:
+      customer: ${cust_bender:id}
+    assert:
+      - status == requires_action
+      - amount_received == 1500
+      - customer == "${cust_bender:id}"
+      - charges.data.# == 3
+      - amount >= 2000
+      - livemode == true
+`
+
+func TestExecuteWithAssertions(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch url := req.URL.String(); url {
+		case customersPath:
+			res.Write([]byte(`{"id": "cust_12345", "name": "Bender"}`))
+		case "/v1/payment_intents":
+			res.Write([]byte(`{
+				"id": "pi_12345",
+				"customer": "cust_12345",
+				"status": "requires_action",
+				"amount": 1500,
+				"amount_received": 1500,
+				"livemode": false,
+				"charges": {"data": [{}, {}, {}]}
+			}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, "assert_fixture.yaml", []byte(assertTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, "assert_fixture.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	var progress strings.Builder
+	fxt.Progress = &progress
+
+	_, err = fxt.Execute(context.Background(), "")
+
+	var assertionErr AssertionError
+	require.True(t, errors.As(err, &assertionErr))
+	require.Equal(t, []AssertionFailure{
+		{Fixture: "pi_bender", Expression: "amount >= 2000", Actual: "1500"},
+		{Fixture: "pi_bender", Expression: "livemode == true", Actual: "false"},
+	}, assertionErr.Failures)
+
+	// The progress of the steps is written to the Progress writer
+	require.Contains(t, progress.String(), "Running fixture for: pi_bender\n")
+	require.Contains(t, progress.String(), "✘ amount >= 2000 (actual: 1500)\n")
+}
+
+func TestExecuteWithInvalidAssertion(t *testing.T) {
+	fxt, err := NewFixtureFromRawString(afero.NewMemMapFs(), apiKey, "", "", `{
+		"fixtures": [
+			{"name": "customer", "path": "/v1/customers", "method": "post", "assert": ["status is paid"]}
+		]
+	}`)
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.EqualError(t, err, "customer: invalid assertion: status is paid")
+}
+
+const waitTestFixture = `
+fixtures:
+  - name: report_run
+    path: /v1/reporting/report_runs
+    method: post
+  - name: report_ready
+    path: /v1/reporting/report_runs/${report_run:id}
+    wait:
+      until: status == "succeeded"
+      interval: 1ms
+      timeout: 1s
+`
+
+func TestExecuteWithWait(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch url := req.URL.String(); url {
+		case "/v1/reporting/report_runs":
+			res.Write([]byte(`{"id": "frr_123", "status": "pending"}`))
+		case "/v1/reporting/report_runs/frr_123":
+			require.Equal(t, http.MethodGet, req.Method)
+			polls++
+			if polls < 3 {
+				res.Write([]byte(`{"id": "frr_123", "status": "pending"}`))
+			} else {
+				res.Write([]byte(`{"id": "frr_123", "status": "succeeded"}`))
+			}
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, "wait_fixture.yaml", []byte(waitTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, "wait_fixture.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, 3, polls)
+	require.Equal(t, "succeeded", fxt.responses["report_ready"].Get("status").String())
+}
+
+func TestExecuteWithIncludedWait(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch url := req.URL.String(); url {
+		case "/v1/reporting/report_runs":
+			res.Write([]byte(`{"id": "frr_123", "status": "pending"}`))
+		case "/v1/reporting/report_runs/frr_123":
+			res.Write([]byte(`{"id": "frr_123", "status": "succeeded"}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+	defer ts.Close()
+
+	fixture := strings.Replace(waitTestFixture, `until: status == "succeeded"`, `until: id == "${report_run:id}"`, 1)
+	afero.WriteFile(fs, filepath.Join("fixtures", "common", "report.yaml"), []byte(fixture), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join("fixtures", "reports.yaml"), []byte(`
+include:
+  - common/report.yaml
+fixtures: []
+`), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, filepath.Join("fixtures", "reports.yaml"), []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "succeeded", fxt.responses["report.report_ready"].Get("status").String())
+}
+
+func TestExecuteWithWaitTimeout(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Write([]byte(`{"id": "frr_123", "status": "pending"}`))
+	}))
+
+	defer func() { ts.Close() }()
+
+	fixture := strings.Replace(waitTestFixture, "timeout: 1s", "timeout: 5ms", 1)
+	afero.WriteFile(fs, "wait_fixture.yaml", []byte(fixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, "wait_fixture.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+
+	var timeoutErr WaitTimeoutError
+	require.True(t, errors.As(err, &timeoutErr))
+	require.Equal(t, "report_ready", timeoutErr.Fixture)
+	require.Equal(t, `"pending"`, timeoutErr.Actual)
+}
+
+const testClockTestFixture = `
+fixtures:
+  - name: clock
+    test_clock:
+      frozen_time: 1700000000
+  - name: customer
+    path: /v1/customers
+    method: post
+    params:
+      test_clock: ${clock:id}
+  - name: renewal
+    advance:
+      test_clock: ${clock:id}
+      by: 1d12h
+      interval: 1ms
+`
+
+// testClockServer serves the requests of testClockTestFixture
+func testClockServer(t *testing.T) *httptest.Server {
+	status := "ready"
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("Failure with request body: %s", err)
+		}
+
+		switch url := req.URL.String(); url {
+		case "/v1/test_helpers/test_clocks":
+			require.Equal(t, "frozen_time=1700000000", string(body))
+			res.Write([]byte(`{"id": "clock_123", "frozen_time": 1700000000, "status": "ready"}`))
+		case customersPath:
+			require.True(t, strings.Contains(string(body), "test_clock=clock_123"))
+			res.Write([]byte(`{"id": "cus_123"}`))
+		case "/v1/test_helpers/test_clocks/clock_123/advance":
+			require.Equal(t, "frozen_time=1700129600", string(body))
+			status = "advancing"
+			res.Write([]byte(`{"id": "clock_123", "status": "advancing"}`))
+		case "/v1/test_helpers/test_clocks/clock_123":
+			res.Write([]byte(fmt.Sprintf(`{"id": "clock_123", "frozen_time": 1700000000, "status": "%s"}`, status)))
+			if status == "advancing" {
+				status = "ready"
+			}
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+}
+
+func TestExecuteWithTestClock(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := testClockServer(t)
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, "test_clock_fixture.yaml", []byte(testClockTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, "test_clock_fixture.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, "ready", fxt.responses["renewal"].Get("status").String())
+}
+
+func TestExecuteWithIncludedTestClock(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := testClockServer(t)
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, filepath.Join("fixtures", "common", "billing.yaml"), []byte(testClockTestFixture), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join("fixtures", "renewal.yaml"), []byte(`
+include:
+  - common/billing.yaml
+fixtures: []
+`), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, filepath.Join("fixtures", "renewal.yaml"), []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, "ready", fxt.responses["billing.renewal"].Get("status").String())
+}
+
+func TestParseAdvanceDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"90m":   90 * time.Minute,
+		"31d":   31 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+	}
+
+	for input, expected := range tests {
+		duration, err := parseAdvanceDuration(input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, duration)
+	}
+
+	_, err := parseAdvanceDuration("1 month")
+	require.Error(t, err)
+}
+
+func TestResumeRun(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	var idempotencyKeys []string
+	failCharge := true
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		idempotencyKeys = append(idempotencyKeys, req.Header.Get("Idempotency-Key"))
+
+		switch req.URL.String() {
+		case customersPath:
+			res.Write([]byte(`{"id": "cust_12345"}`))
+		case chargePath:
+			if failCharge {
+				res.WriteHeader(http.StatusInternalServerError)
+				res.Write([]byte(`{"error": {"type": "api_error"}}`))
+				return
+			}
+			res.Write([]byte(`{"id": "char_12345"}`))
+		case capturePath:
+			res.Write([]byte(`{"id": "char_12345", "captured": true}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+	defer ts.Close()
+
+	afero.WriteFile(fs, file, []byte(testFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, file, []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+	fxt.TrackRun(&RunState{RunID: "run_123", File: file})
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.Error(t, err)
+	// The charge was retried with the same idempotency key
+	require.Equal(t, []string{
+		"fixture-test_fixture-cust_bender-run_123",
+		"fixture-test_fixture-char_bender-run_123",
+		"fixture-test_fixture-char_bender-run_123",
+		"fixture-test_fixture-char_bender-run_123",
+	}, idempotencyKeys)
+
+	state, err := LoadRunState(fs, "run_123")
+	require.NoError(t, err)
+	require.Equal(t, []string{"cust_bender"}, state.Completed)
+	require.Equal(t, "char_bender", state.FailedStep)
+
+	// Resuming skips the customer creation and reuses its response
+	idempotencyKeys = nil
+	failCharge = false
+
+	fxt, err = NewFixtureFromFile(fs, apiKey, "", ts.URL, state.File, state.Skip, state.Override, state.Add, state.Remove)
+	require.NoError(t, err)
+	fxt.TrackRun(state)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+	// The failed step is retried with a new idempotency key
+	require.Equal(t, []string{"fixture-test_fixture-char_bender-run_123-1", "fixture-test_fixture-capt_bender-run_123"}, idempotencyKeys)
+
+	_, err = LoadRunState(fs, "run_123")
+	require.EqualError(t, err, "no saved fixture run with ID run_123")
+}
+
+func TestCheckResume(t *testing.T) {
+	state := &RunState{RunID: "run_123", StripeAccount: "acct_123", APIVersion: "2020-08-27"}
+
+	require.NoError(t, state.CheckResume("acct_123", "2020-08-27"))
+	require.EqualError(t, state.CheckResume("", "2020-08-27"), `fixture run run_123 was started with --stripe-account "acct_123" and must be resumed with the same account`)
+	require.EqualError(t, state.CheckResume("acct_123", ""), `fixture run run_123 was started with --api-version "2020-08-27" and must be resumed with the same API version`)
+}
+
+const multipartTestFixture = `
+_meta:
+  template_version: 0
+fixtures:
+  - name: evidence
+    path: /v1/files
+    method: post
+    multipart: true
+    params:
+      purpose: dispute_evidence
+      file: ./evidence.pdf
+`
+
+func TestExecuteWithMultipart(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	dir := t.TempDir()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "evidence.pdf"), []byte("%PDF-evidence"), 0600))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/v1/files", req.URL.Path)
+		require.NoError(t, req.ParseMultipartForm(1<<20))
+
+		require.Equal(t, "dispute_evidence", req.FormValue("purpose"))
+		require.Empty(t, req.FormValue("metadata[_created_by_fixture]"))
+
+		file, header, err := req.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, "%PDF-evidence", string(content))
+		require.Equal(t, "evidence.pdf", filepath.Base(header.Filename))
+
+		res.Write([]byte(`{"id": "file_123"}`))
+	}))
+	defer ts.Close()
+
+	fixtureFile := filepath.Join(dir, "upload.yaml")
+	afero.WriteFile(fs, fixtureFile, []byte(multipartTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, fixtureFile, []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "file_123", fxt.responses["evidence"].Get("id").String())
+}
+
+func TestExecuteWithIncludedMultipart(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	dir := t.TempDir()
+
+	// The file is next to the included fixture, not the including one
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "common"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "common", "evidence.pdf"), []byte("%PDF-evidence"), 0600))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		require.NoError(t, req.ParseMultipartForm(1<<20))
+
+		file, _, err := req.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.Equal(t, "%PDF-evidence", string(content))
+
+		res.Write([]byte(`{"id": "file_123"}`))
+	}))
+	defer ts.Close()
+
+	afero.WriteFile(fs, filepath.Join(dir, "common", "upload.yaml"), []byte(multipartTestFixture), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join(dir, "dispute.yaml"), []byte(`
+_meta:
+  template_version: 0
+include:
+  - common/upload.yaml
+fixtures: []
+`), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, filepath.Join(dir, "dispute.yaml"), []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+	require.Equal(t, "file_123", fxt.responses["upload.evidence"].Get("id").String())
+}
+
+func TestExecuteWithInvalidMultipart(t *testing.T) {
+	fxt, err := NewFixtureFromRawString(afero.NewMemMapFs(), apiKey, "", "", `{
+		"_meta": {"template_version": 0},
+		"fixtures": [{"name": "evidence", "path": "/v1/files", "method": "post", "multipart": true, "params": {"purpose": "dispute_evidence"}}]
+	}`)
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.EqualError(t, err, "evidence: multipart steps must have a file param with the path of the file to upload")
+}
+
+const connectTestFixture = `
+_meta:
+  template_version: 0
+fixtures:
+  - name: acct
+    path: /v1/accounts
+    method: post
+    params:
+      type: express
+  - name: product
+    path: /v1/products
+    method: post
+    stripe_account: ${acct:id}
+    params:
+      name: Connected product
+  - name: fee
+    path: /v1/application_fees
+    method: get
+    stripe_account: ""
+`
+
+func TestExecuteWithStepStripeAccount(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	accounts := make(map[string]string)
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		accounts[req.URL.Path] = req.Header.Get("Stripe-Account")
+
+		switch req.URL.Path {
+		case "/v1/accounts":
+			res.Write([]byte(`{"id": "acct_123"}`))
+		default:
+			res.Write([]byte(`{"id": "obj_123"}`))
+		}
+	}))
+	defer ts.Close()
+
+	afero.WriteFile(fs, "connect.yaml", []byte(connectTestFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "acct_platform_default", ts.URL, "connect.yaml", []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]string{
+		"/v1/accounts":         "acct_platform_default",
+		"/v1/products":         "acct_123",
+		"/v1/application_fees": "",
+	}, accounts)
+}
+
+func TestExecuteRecordsResults(t *testing.T) {
+	fs := afero.NewMemMapFs()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case customersPath:
+			res.Header().Set("Request-Id", "req_customer")
+			res.Write([]byte(`{"id": "cust_12345"}`))
+		case chargePath:
+			res.Header().Set("Request-Id", "req_charge")
+			res.WriteHeader(http.StatusPaymentRequired)
+			res.Write([]byte(`{"error": {"type": "card_error"}}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+	defer ts.Close()
+
+	afero.WriteFile(fs, file, []byte(testFixture), os.ModePerm)
+
+	fxt, err := NewFixtureFromFile(fs, apiKey, "", ts.URL, file, []string{}, []string{}, []string{}, []string{})
+	require.NoError(t, err)
+
+	_, err = fxt.Execute(context.Background(), "")
+	require.Error(t, err)
+
+	results := fxt.Results()
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "cust_bender", results[0].Name)
+	assert.Equal(t, http.StatusOK, results[0].Status)
+	assert.Equal(t, "req_customer", results[0].RequestID)
+	assert.Equal(t, "cust_12345", results[0].ObjectID)
+	assert.Empty(t, results[0].Error)
+
+	assert.Equal(t, "char_bender", results[1].Name)
+	assert.Equal(t, http.StatusPaymentRequired, results[1].Status)
+	assert.Equal(t, "req_charge", results[1].RequestID)
+	assert.Contains(t, results[1].Error, "card_error")
+
+	var jsonReport strings.Builder
+	require.NoError(t, WriteReport(&jsonReport, ReportFormatForFile("report.json"), "test_fixture", results))
+	assert.Equal(t, "req_customer", gjson.Get(jsonReport.String(), "steps.0.request_id").String())
+	assert.True(t, gjson.Get(jsonReport.String(), "steps.1.duration_seconds").Exists())
+
+	var junitReport strings.Builder
+	require.NoError(t, WriteReport(&junitReport, ReportFormatForFile("junit.xml"), "test_fixture", results))
+	assert.Contains(t, junitReport.String(), `<testsuite name="test_fixture" tests="2" failures="1" skipped="0"`)
+	assert.Contains(t, junitReport.String(), `http_status=200 request_id=req_customer object_id=cust_12345`)
+}
@@ -0,0 +1,400 @@
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/requests"
+)
+
+// The functions in this file generate a fixture from an existing event.
+// The request that produced an event cannot be retrieved through the API,
+// so the fixture is derived from the event's object instead: the object is
+// recreated with its create params, every object it references (customer,
+// product, price, ...) is recreated first, and for `*.updated` and
+// `*.deleted` events a final step reproduces the update or deletion.
+
+// objectSpec describes how to create an object of a given type
+type objectSpec struct {
+	Path   string
+	Params []string
+	// Defaults are params added to the create request, for values that
+	// cannot be copied from the object such as a test payment method
+	Defaults map[string]interface{}
+	// Parents are alternative params referencing the object this one
+	// belongs to, by order of preference. Only the first one set on the
+	// object is kept, since the create request accepts a single one.
+	Parents []string
+}
+
+// objectSpecs maps the `object` value of API resources to how they are
+// created. Params that are themselves the name of an object type in this
+// map are treated as references to that object.
+var objectSpecs = map[string]objectSpec{
+	"charge": {
+		Path:     "/v1/charges",
+		Params:   []string{"amount", "currency", "customer", "description", "metadata", "receipt_email", "shipping", "statement_descriptor"},
+		Defaults: map[string]interface{}{"source": "tok_visa"},
+	},
+	"coupon": {
+		Path:   "/v1/coupons",
+		Params: []string{"amount_off", "currency", "duration", "duration_in_months", "max_redemptions", "metadata", "name", "percent_off"},
+	},
+	"customer": {
+		Path:   "/v1/customers",
+		Params: []string{"address", "balance", "description", "email", "metadata", "name", "phone", "preferred_locales", "shipping", "tax_exempt"},
+	},
+	"invoice": {
+		Path:   "/v1/invoices",
+		Params: []string{"auto_advance", "collection_method", "customer", "days_until_due", "description", "footer", "metadata"},
+	},
+	"invoiceitem": {
+		Path:   "/v1/invoiceitems",
+		Params: []string{"amount", "currency", "customer", "description", "metadata"},
+	},
+	"payment_intent": {
+		Path:     "/v1/payment_intents",
+		Params:   []string{"amount", "capture_method", "currency", "customer", "description", "metadata", "payment_method_types", "receipt_email", "setup_future_usage", "shipping", "statement_descriptor"},
+		Defaults: map[string]interface{}{"payment_method": "pm_card_visa"},
+	},
+	"payout": {
+		Path:   "/v1/payouts",
+		Params: []string{"amount", "currency", "description", "metadata", "method", "statement_descriptor"},
+	},
+	"plan": {
+		Path:   "/v1/plans",
+		Params: []string{"amount", "billing_scheme", "currency", "interval", "interval_count", "metadata", "nickname", "product", "trial_period_days", "usage_type"},
+	},
+	"price": {
+		Path:   "/v1/prices",
+		Params: []string{"billing_scheme", "currency", "lookup_key", "metadata", "nickname", "product", "recurring", "tax_behavior", "unit_amount"},
+	},
+	"product": {
+		Path:   "/v1/products",
+		Params: []string{"active", "description", "images", "metadata", "name", "shippable", "statement_descriptor", "tax_code", "unit_label", "url"},
+	},
+	"refund": {
+		Path:    "/v1/refunds",
+		Params:  []string{"amount", "charge", "metadata", "payment_intent", "reason"},
+		Parents: []string{"payment_intent", "charge"},
+	},
+	"setup_intent": {
+		Path:     "/v1/setup_intents",
+		Params:   []string{"customer", "description", "metadata", "payment_method_types", "usage"},
+		Defaults: map[string]interface{}{"payment_method": "pm_card_visa"},
+	},
+	"subscription": {
+		Path:   "/v1/subscriptions",
+		Params: []string{"cancel_at_period_end", "collection_method", "customer", "days_until_due", "description", "metadata"},
+	},
+	"tax_rate": {
+		Path:   "/v1/tax_rates",
+		Params: []string{"country", "description", "display_name", "inclusive", "jurisdiction", "metadata", "percentage", "state", "tax_type"},
+	},
+}
+
+// parent returns the first of the parent params set on object
+func (spec objectSpec) parent(object gjson.Result) string {
+	for _, param := range spec.Parents {
+		if value := object.Get(param); value.Exists() && value.Type != gjson.Null {
+			return param
+		}
+	}
+
+	return ""
+}
+
+// confirmedStatuses are the statuses of intents that require confirming
+// the intent when it is recreated
+var confirmedStatuses = map[string]bool{
+	"processing":       true,
+	"requires_capture": true,
+	"succeeded":        true,
+}
+
+type generatedFixtureFile struct {
+	Meta     metaFixture     `json:"_meta"`
+	Fixtures []generatedStep `json:"fixtures"`
+}
+
+type generatedStep struct {
+	Name   string                 `json:"name"`
+	Path   string                 `json:"path"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// FixtureGenerator builds fixture files from existing API objects
+type FixtureGenerator struct {
+	APIKey        string
+	StripeAccount string
+	BaseURL       string
+
+	steps []generatedStep
+	// names maps the IDs of the objects already added to their step name
+	names map[string]string
+	// counts tracks how many steps were created per object type, to
+	// generate unique step names
+	counts map[string]int
+}
+
+// GeneratedFromEvent describes the event a fixture was generated from
+type GeneratedFromEvent struct {
+	EventID   string
+	EventType string
+	RequestID string
+}
+
+// GenerateFromEvent retrieves an event and returns a fixture file (as
+// indented JSON) that recreates the object of the event.
+func (g *FixtureGenerator) GenerateFromEvent(ctx context.Context, eventID string) ([]byte, GeneratedFromEvent, error) {
+	g.steps = nil
+	g.names = make(map[string]string)
+	g.counts = make(map[string]int)
+
+	evt, err := g.retrieve(ctx, "/v1/events/"+eventID)
+	if err != nil {
+		return nil, GeneratedFromEvent{}, err
+	}
+
+	source := GeneratedFromEvent{
+		EventID:   evt.Get("id").String(),
+		EventType: evt.Get("type").String(),
+		RequestID: evt.Get("request.id").String(),
+	}
+
+	object := evt.Get("data.object")
+	previous := evt.Get("data.previous_attributes")
+
+	name, err := g.addObject(ctx, object, previous)
+	if err != nil {
+		return nil, source, err
+	}
+
+	spec := objectSpecs[object.Get("object").String()]
+	switch {
+	case strings.HasSuffix(source.EventType, ".deleted"):
+		g.steps = append(g.steps, generatedStep{
+			Name:   name + "_deleted",
+			Path:   fmt.Sprintf("%s/${%s:id}", spec.Path, name),
+			Method: "delete",
+		})
+	case previous.Exists():
+		// Recreate the object with its previous values, then update it to
+		// its current values to produce the same event
+		params := make(map[string]interface{})
+		previous.ForEach(func(key, _ gjson.Result) bool {
+			if isNameIn(key.String(), spec.Params) {
+				if value := cleanValue(object.Get(key.String()).Value()); value != nil {
+					params[key.String()] = value
+				}
+			}
+			return true
+		})
+
+		if len(params) > 0 {
+			g.steps = append(g.steps, generatedStep{
+				Name:   name + "_updated",
+				Path:   fmt.Sprintf("%s/${%s:id}", spec.Path, name),
+				Method: "post",
+				Params: params,
+			})
+		}
+	}
+
+	data, err := json.MarshalIndent(generatedFixtureFile{
+		Meta:     metaFixture{Version: SupportedVersions},
+		Fixtures: g.steps,
+	}, "", "  ")
+	if err != nil {
+		return nil, source, err
+	}
+
+	return append(data, '\n'), source, nil
+}
+
+// addObject adds a step creating object, after the steps creating the
+// objects it references, and returns the name of the step
+func (g *FixtureGenerator) addObject(ctx context.Context, object gjson.Result, previous gjson.Result) (string, error) {
+	id := object.Get("id").String()
+	if name, ok := g.names[id]; ok {
+		return name, nil
+	}
+
+	objectType := object.Get("object").String()
+	spec, ok := objectSpecs[objectType]
+	if !ok {
+		return "", fmt.Errorf("generating fixtures for %s objects is not supported", objectType)
+	}
+
+	parent := spec.parent(object)
+
+	params := make(map[string]interface{})
+	for _, param := range spec.Params {
+		value := object.Get(param)
+		if previous.Get(param).Exists() {
+			value = previous.Get(param)
+		}
+
+		if !value.Exists() || value.Type == gjson.Null {
+			continue
+		}
+
+		// A refund of a payment intent also references the charge of the
+		// intent, which is recreated by the intent rather than separately
+		if isNameIn(param, spec.Parents) && param != parent {
+			continue
+		}
+
+		if _, isReference := objectSpecs[param]; isReference {
+			name, err := g.addReference(ctx, param, value)
+			if err != nil {
+				return "", err
+			}
+
+			params[param] = fmt.Sprintf("${%s:id}", name)
+			continue
+		}
+
+		if cleaned := cleanValue(value.Value()); cleaned != nil {
+			params[param] = cleaned
+		}
+	}
+
+	for key, value := range spec.Defaults {
+		params[key] = value
+	}
+
+	if err := g.addSpecialParams(ctx, objectType, object, params); err != nil {
+		return "", err
+	}
+
+	g.counts[objectType]++
+	name := objectType
+	if g.counts[objectType] > 1 {
+		name = fmt.Sprintf("%s_%d", objectType, g.counts[objectType])
+	}
+
+	g.names[id] = name
+	g.steps = append(g.steps, generatedStep{
+		Name:   name,
+		Path:   spec.Path,
+		Method: "post",
+		Params: params,
+	})
+
+	return name, nil
+}
+
+// addReference adds the object referenced by a param, which is either an
+// ID or an expanded object
+func (g *FixtureGenerator) addReference(ctx context.Context, objectType string, value gjson.Result) (string, error) {
+	if value.IsObject() {
+		return g.addObject(ctx, value, gjson.Result{})
+	}
+
+	if name, ok := g.names[value.String()]; ok {
+		return name, nil
+	}
+
+	referenced, err := g.retrieve(ctx, fmt.Sprintf("%s/%s", objectSpecs[objectType].Path, value.String()))
+	if err != nil {
+		return "", err
+	}
+
+	return g.addObject(ctx, referenced, gjson.Result{})
+}
+
+// addSpecialParams handles params that cannot be copied as-is from the
+// object
+func (g *FixtureGenerator) addSpecialParams(ctx context.Context, objectType string, object gjson.Result, params map[string]interface{}) error {
+	switch objectType {
+	case "payment_intent", "setup_intent":
+		if confirmedStatuses[object.Get("status").String()] {
+			params["confirm"] = true
+		}
+	case "subscription":
+		var items []interface{}
+		for _, item := range object.Get("items.data").Array() {
+			name, err := g.addReference(ctx, "price", item.Get("price"))
+			if err != nil {
+				return err
+			}
+
+			items = append(items, map[string]interface{}{
+				"price":    fmt.Sprintf("${%s:id}", name),
+				"quantity": item.Get("quantity").Int(),
+			})
+		}
+
+		if len(items) > 0 {
+			params["items"] = items
+		}
+
+		// Subscriptions need a way to pay
+		if _, ok := params["collection_method"]; !ok || params["collection_method"] == "charge_automatically" {
+			params["default_payment_method"] = "pm_card_visa"
+		}
+	}
+
+	return nil
+}
+
+func (g *FixtureGenerator) retrieve(ctx context.Context, path string) (gjson.Result, error) {
+	var params requests.RequestParameters
+	params.SetStripeAccount(g.StripeAccount)
+
+	req := requests.Base{
+		Method:         http.MethodGet,
+		SuppressOutput: true,
+		APIBaseURL:     g.BaseURL,
+	}
+
+	resp, err := req.MakeRequest(ctx, g.APIKey, path, &params, true)
+	if err != nil {
+		return gjson.Result{}, err
+	}
+
+	return gjson.ParseBytes(resp), nil
+}
+
+// cleanValue removes null values, empty objects and the metadata added
+// by fixtures from a value copied from an API object
+func cleanValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		cleaned := make(map[string]interface{})
+		for key, val := range v {
+			if key == "_created_by_fixture" {
+				continue
+			}
+			if c := cleanValue(val); c != nil {
+				cleaned[key] = c
+			}
+		}
+		if len(cleaned) == 0 {
+			return nil
+		}
+		return cleaned
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		cleaned := make([]interface{}, 0, len(v))
+		for _, val := range v {
+			if c := cleanValue(val); c != nil {
+				cleaned = append(cleaned, c)
+			}
+		}
+		return cleaned
+	default:
+		return v
+	}
+}
@@ -0,0 +1,127 @@
+package fixtures
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateFromEvent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		require.Equal(t, http.MethodGet, req.Method)
+
+		switch req.URL.Path {
+		case "/v1/events/evt_123":
+			res.Write([]byte(`{
+				"id": "evt_123",
+				"type": "price.updated",
+				"request": {"id": "req_123"},
+				"data": {
+					"object": {
+						"id": "price_123",
+						"object": "price",
+						"currency": "usd",
+						"unit_amount": 2000,
+						"nickname": "Gold",
+						"product": "prod_123",
+						"lookup_key": null,
+						"metadata": {"_created_by_fixture": "true"},
+						"recurring": {"interval": "month", "interval_count": 1, "aggregate_usage": null}
+					},
+					"previous_attributes": {"nickname": "Silver", "livemode": false}
+				}
+			}`))
+		case "/v1/products/prod_123":
+			res.Write([]byte(`{"id": "prod_123", "object": "product", "name": "Gold plan", "images": [], "metadata": {}}`))
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	generator := FixtureGenerator{APIKey: "sk_test_1234", BaseURL: ts.URL}
+	data, source, err := generator.GenerateFromEvent(context.Background(), "evt_123")
+	require.NoError(t, err)
+
+	assert.Equal(t, GeneratedFromEvent{EventID: "evt_123", EventType: "price.updated", RequestID: "req_123"}, source)
+
+	steps := gjson.GetBytes(data, "fixtures").Array()
+	require.Len(t, steps, 3)
+
+	assert.Equal(t, "product", steps[0].Get("name").String())
+	assert.Equal(t, "/v1/products", steps[0].Get("path").String())
+	assert.JSONEq(t, `{"name": "Gold plan"}`, steps[0].Get("params").Raw)
+
+	assert.Equal(t, "price", steps[1].Get("name").String())
+	assert.JSONEq(t, `{
+		"currency": "usd",
+		"unit_amount": 2000,
+		"nickname": "Silver",
+		"product": "${product:id}",
+		"recurring": {"interval": "month", "interval_count": 1}
+	}`, steps[1].Get("params").Raw)
+
+	assert.Equal(t, "price_updated", steps[2].Get("name").String())
+	assert.Equal(t, "/v1/prices/${price:id}", steps[2].Get("path").String())
+	assert.JSONEq(t, `{"nickname": "Gold"}`, steps[2].Get("params").Raw)
+
+	// The generated fixture can be loaded back
+	_, err = NewFixtureFromRawString(nil, "sk_test_1234", "", ts.URL, string(data))
+	require.NoError(t, err)
+}
+
+func TestGenerateFromEventSingleParent(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/v1/events/evt_123":
+			res.Write([]byte(`{
+				"id": "evt_123",
+				"type": "charge.refund.updated",
+				"data": {
+					"object": {
+						"id": "re_123",
+						"object": "refund",
+						"amount": 500,
+						"charge": "ch_123",
+						"payment_intent": "pi_123"
+					}
+				}
+			}`))
+		case "/v1/payment_intents/pi_123":
+			res.Write([]byte(`{"id": "pi_123", "object": "payment_intent", "amount": 2000, "currency": "usd", "status": "succeeded"}`))
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+			res.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	generator := FixtureGenerator{APIKey: "sk_test_1234", BaseURL: ts.URL}
+	data, _, err := generator.GenerateFromEvent(context.Background(), "evt_123")
+	require.NoError(t, err)
+
+	// The refund only references the payment intent, whose charge is
+	// created by confirming it
+	steps := gjson.GetBytes(data, "fixtures").Array()
+	require.Len(t, steps, 2)
+	assert.Equal(t, "payment_intent", steps[0].Get("name").String())
+	assert.JSONEq(t, `{"amount": 500, "payment_intent": "${payment_intent:id}"}`, steps[1].Get("params").Raw)
+}
+
+func TestGenerateFromEventUnsupportedObject(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Write([]byte(`{"id": "evt_123", "type": "account.updated", "data": {"object": {"id": "acct_123", "object": "account"}}}`))
+	}))
+	defer ts.Close()
+
+	generator := FixtureGenerator{APIKey: "sk_test_1234", BaseURL: ts.URL}
+	_, _, err := generator.GenerateFromEvent(context.Background(), "evt_123")
+	require.EqualError(t, err, "generating fixtures for account objects is not supported")
+}
@@ -0,0 +1,293 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+	"gopkg.in/yaml.v3"
+)
+
+// The functions in this file are responsible for loading fixture files
+// from disk (or from the embedded triggers) and flattening any files
+// they include into a single list of fixtures.
+//
+// A fixture file can pull in shared building blocks with the `include`
+// directive:
+//
+//	include:
+//	  - common/customer.yaml
+//	  - path: common/payment_method.json
+//	    as: pm
+//
+// Included paths are resolved relative to the including file. Every
+// fixture coming from an included file is namespaced with the include's
+// `as` value (which defaults to the file name without its extension), so
+// a fixture named `customer` in `common/customer.yaml` is referenced as
+// `${customer.customer:id}` from the including file. References inside
+// the included file keep working because they are rewritten as well.
+
+// namespaceSeparator joins an include namespace with a fixture name
+const namespaceSeparator = "."
+
+type fixtureInclude struct {
+	Path      string `json:"path"`
+	Namespace string `json:"as,omitempty"`
+}
+
+// UnmarshalJSON allows an include to be written either as a plain path
+// or as an object with a `path` and an optional `as` namespace.
+func (inc *fixtureInclude) UnmarshalJSON(data []byte) error {
+	var path string
+	if err := json.Unmarshal(data, &path); err == nil {
+		inc.Path = path
+		return nil
+	}
+
+	type plainInclude fixtureInclude
+	var plain plainInclude
+	if err := json.Unmarshal(data, &plain); err != nil {
+		return err
+	}
+
+	*inc = fixtureInclude(plain)
+	return nil
+}
+
+// namespace returns the prefix that fixtures from this include receive
+func (inc fixtureInclude) namespace() string {
+	if inc.Namespace != "" {
+		return inc.Namespace
+	}
+
+	base := filepath.Base(inc.Path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+// referenceRegex matches the `${name:` portion of a fixture query so the
+// name can be namespaced without touching the rest of the query.
+var referenceRegex = regexp.MustCompile(`\${([^\|}:]+):`)
+
+// isYAMLFile reports whether the file should be parsed as YAML
+func isYAMLFile(file string) bool {
+	ext := strings.ToLower(filepath.Ext(file))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// unmarshalFixtureFile parses JSON or YAML fixture data
+func unmarshalFixtureFile(data []byte, isYAML bool) (fixtureFile, error) {
+	var ff fixtureFile
+	err := unmarshalJSONOrYAML(data, isYAML, &ff)
+	return ff, err
+}
+
+// unmarshalJSONOrYAML parses JSON or YAML data into v. YAML is converted
+// to JSON first so both formats share the same struct tags.
+func unmarshalJSONOrYAML(data []byte, isYAML bool, v interface{}) error {
+	if isYAML {
+		var raw interface{}
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+
+		converted, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+
+		data = converted
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// readFixtureData reads a fixture file from the embedded triggers when it
+// is one of the built-in fixtures, otherwise from the given filesystem.
+func readFixtureData(fs afero.Fs, file string, embedded bool) ([]byte, error) {
+	if embedded {
+		f, err := triggers.Open(filepath.ToSlash(file))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return io.ReadAll(f)
+	}
+
+	return afero.ReadFile(fs, file)
+}
+
+// loadFixtureFile reads and parses a fixture file and resolves all of
+// the files it includes.
+func loadFixtureFile(fs afero.Fs, file string, embedded bool, seen []string) (fixtureFile, error) {
+	data, err := readFixtureData(fs, file, embedded)
+	if err != nil {
+		return fixtureFile{}, err
+	}
+
+	ff, err := unmarshalFixtureFile(data, isYAMLFile(file))
+	if err != nil {
+		return fixtureFile{}, err
+	}
+
+	if ff.Meta.Version > SupportedVersions {
+		return fixtureFile{}, fmt.Errorf("Fixture version not supported: %s", fmt.Sprint(ff.Meta.Version))
+	}
+
+	// Relative file paths of the steps are resolved against the directory
+	// of the file that declares them, even when it is included
+	if !embedded {
+		for i := range ff.Fixtures {
+			ff.Fixtures[i].dir = filepath.Dir(file)
+		}
+	}
+
+	return resolveIncludes(fs, ff, filepath.Dir(file), embedded, append(seen, file))
+}
+
+// resolveIncludes loads every file included by ff and prepends their
+// namespaced fixtures so they run before the fixtures that use them.
+func resolveIncludes(fs afero.Fs, ff fixtureFile, dir string, embedded bool, seen []string) (fixtureFile, error) {
+	if len(ff.Include) == 0 {
+		return ff, nil
+	}
+
+	var included []fixture
+	for _, inc := range ff.Include {
+		if inc.Path == "" {
+			return fixtureFile{}, fmt.Errorf("fixture include is missing a path")
+		}
+
+		file := inc.Path
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(dir, file)
+		}
+
+		if isNameIn(file, seen) {
+			return fixtureFile{}, fmt.Errorf("fixture include cycle detected: %s -> %s", strings.Join(seen, " -> "), file)
+		}
+
+		child, err := loadFixtureFile(fs, file, embedded, seen)
+		if err != nil {
+			return fixtureFile{}, fmt.Errorf("failed to include fixture file %s: %w", inc.Path, err)
+		}
+
+		child = namespaceFixtureFile(child, inc.namespace())
+		included = append(included, child.Fixtures...)
+
+		// Env and output mappings from the including file always win
+		// over the mappings of the files it includes
+		ff.Env = mergeMappings(ff.Env, child.Env)
+		ff.Outputs = mergeMappings(ff.Outputs, child.Outputs)
+	}
+
+	ff.Fixtures = append(included, ff.Fixtures...)
+	ff.Include = nil
+
+	return ff, nil
+}
+
+// namespaceFixtureFile prefixes every fixture name in ff with namespace
+// and rewrites all references to those fixtures accordingly.
+func namespaceFixtureFile(ff fixtureFile, namespace string) fixtureFile {
+	names := make(map[string]bool, len(ff.Fixtures))
+	for _, f := range ff.Fixtures {
+		names[f.Name] = true
+	}
+
+	rewrite := func(value string) string {
+		return rewriteReferences(value, names, namespace)
+	}
+
+	for i, f := range ff.Fixtures {
+		ff.Fixtures[i].Name = namespace + namespaceSeparator + f.Name
+		ff.Fixtures[i].Path = rewrite(f.Path)
+		if f.Params != nil {
+			ff.Fixtures[i].Params = rewriteValue(f.Params, rewrite).(map[string]interface{})
+		}
+		if f.StripeAccount != nil {
+			account := rewrite(*f.StripeAccount)
+			ff.Fixtures[i].StripeAccount = &account
+		}
+		if f.Wait != nil {
+			wait := *f.Wait
+			wait.Until = rewrite(wait.Until)
+			ff.Fixtures[i].Wait = &wait
+		}
+		if f.Advance != nil {
+			advance := *f.Advance
+			advance.TestClock = rewrite(advance.TestClock)
+			ff.Fixtures[i].Advance = &advance
+		}
+		if f.Assert != nil {
+			ff.Fixtures[i].Assert = make([]string, len(f.Assert))
+			for j, expression := range f.Assert {
+				ff.Fixtures[i].Assert[j] = rewrite(expression)
+			}
+		}
+	}
+
+	for key, value := range ff.Env {
+		ff.Env[key] = rewrite(value)
+	}
+
+	for key, value := range ff.Outputs {
+		ff.Outputs[key] = rewrite(value)
+	}
+
+	return ff
+}
+
+// mergeMappings adds every key of src that is missing from dst
+func mergeMappings(dst, src map[string]string) map[string]string {
+	for key, value := range src {
+		if dst == nil {
+			dst = make(map[string]string)
+		}
+		if _, ok := dst[key]; !ok {
+			dst[key] = value
+		}
+	}
+
+	return dst
+}
+
+// rewriteReferences namespaces every query in value that refers to one
+// of the given fixture names. Other queries, such as `${.env:KEY}`, are
+// left untouched.
+func rewriteReferences(value string, names map[string]bool, namespace string) string {
+	return referenceRegex.ReplaceAllStringFunc(value, func(match string) string {
+		name := referenceRegex.FindStringSubmatch(match)[1]
+		if !names[name] {
+			return match
+		}
+
+		return fmt.Sprintf("${%s%s%s:", namespace, namespaceSeparator, name)
+	})
+}
+
+// rewriteValue recursively applies rewrite to every string in value
+func rewriteValue(value interface{}, rewrite func(string) string) interface{} {
+	switch v := value.(type) {
+	case string:
+		return rewrite(v)
+	case map[string]interface{}:
+		rewritten := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			rewritten[key] = rewriteValue(val, rewrite)
+		}
+		return rewritten
+	case []interface{}:
+		rewritten := make([]interface{}, len(v))
+		for i, val := range v {
+			rewritten[i] = rewriteValue(val, rewrite)
+		}
+		return rewritten
+	default:
+		return value
+	}
+}
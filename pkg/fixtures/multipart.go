@@ -0,0 +1,85 @@
+package fixtures
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/stripe/stripe-cli/pkg/stripe"
+)
+
+// Multipart steps upload a file, for flows such as dispute evidence,
+// identity documents or account onboarding:
+//
+//	{
+//	  "name": "evidence",
+//	  "path": "/v1/files",
+//	  "method": "post",
+//	  "multipart": true,
+//	  "params": {
+//	    "purpose": "dispute_evidence",
+//	    "file": "./evidence.pdf"
+//	  }
+//	}
+//
+// The `file` param is the path of the file to upload, relative to the
+// fixture file that declares the step, which can be an included file. The
+// other params are sent as form fields. Requests to the Stripe API are sent
+// to the files API host.
+
+const multipartFileParam = "file"
+
+// validateMultipart checks the configuration of every multipart step
+// before any request is made
+func (fxt *Fixture) validateMultipart() error {
+	for _, data := range fxt.fixture.Fixtures {
+		if !data.Multipart {
+			continue
+		}
+
+		if !strings.EqualFold(data.Method, "post") {
+			return fmt.Errorf("%s: multipart steps must use the post method", data.Name)
+		}
+
+		if _, ok := data.Params[multipartFileParam].(string); !ok {
+			return fmt.Errorf("%s: multipart steps must have a %s param with the path of the file to upload", data.Name, multipartFileParam)
+		}
+	}
+
+	return nil
+}
+
+// multipartParams returns the params of a multipart step, with the file
+// param resolved to the file to upload
+func (fxt *Fixture) multipartParams(data fixture) (map[string]interface{}, error) {
+	params := data.Params
+
+	path, err := fxt.parseQuery(params[multipartFileParam].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(path, "@")
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(data.dir, path)
+	}
+
+	resolved := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		resolved[key] = value
+	}
+
+	// Values prefixed with @ are sent as form files
+	resolved[multipartFileParam] = "@" + path
+
+	return resolved, nil
+}
+
+// filesBaseURL returns the base URL for file uploads
+func (fxt *Fixture) filesBaseURL() string {
+	if fxt.BaseURL == stripe.DefaultAPIBaseURL {
+		return stripe.DefaultFilesAPIBaseURL
+	}
+
+	return fxt.BaseURL
+}
@@ -0,0 +1,98 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// Supported formats for exporting fixture outputs
+const (
+	OutputFormatJSON   = "json"
+	OutputFormatDotenv = "dotenv"
+	OutputFormatShell  = "shell"
+)
+
+// OutputFormats is the list of formats WriteOutputs supports
+var OutputFormats = []string{OutputFormatJSON, OutputFormatDotenv, OutputFormatShell}
+
+// Outputs resolves the `outputs` section of the fixture against the
+// responses of the requests that were executed. Each output maps a name
+// to a query such as `${price:id}`.
+func (fxt *Fixture) Outputs() (map[string]string, error) {
+	outputs := make(map[string]string, len(fxt.fixture.Outputs))
+
+	for name, query := range fxt.fixture.Outputs {
+		value, err := fxt.parseQuery(query)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[name] = value
+	}
+
+	return outputs, nil
+}
+
+// WriteOutputs resolves the fixture outputs and writes them to w in the
+// given format
+func (fxt *Fixture) WriteOutputs(w io.Writer, format string) error {
+	outputs, err := fxt.Outputs()
+	if err != nil {
+		return err
+	}
+
+	content, err := formatOutputs(outputs, format)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, content)
+	return err
+}
+
+func formatOutputs(outputs map[string]string, format string) (string, error) {
+	switch format {
+	case OutputFormatJSON:
+		data, err := json.MarshalIndent(outputs, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case OutputFormatDotenv:
+		content, err := godotenv.Marshal(outputs)
+		if err != nil {
+			return "", err
+		}
+		return content + "\n", nil
+	case OutputFormatShell:
+		var sb strings.Builder
+		for _, name := range sortedKeys(outputs) {
+			sb.WriteString(fmt.Sprintf("export %s=%s\n", name, shellQuote(outputs[name])))
+		}
+		return sb.String(), nil
+	default:
+		return "", fmt.Errorf("unsupported output format: %s. Supported formats are: %s", format, strings.Join(OutputFormats, ", "))
+	}
+}
+
+// shellQuote wraps value in single quotes so it can be safely eval'd by
+// POSIX shells
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -4,12 +4,11 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"reflect"
 	"regexp"
-	"strconv"
 	"strings"
 
 	"github.com/stripe/stripe-cli/pkg/ansi"
+	"github.com/stripe/stripe-cli/pkg/requests"
 )
 
 // The functions in this file are responsible for taking the JSON
@@ -75,35 +74,19 @@ func (fxt *Fixture) parsePath(http fixture) (string, error) {
 }
 
 // parseInterface is the primary entrypoint into building the request
-// data for fixtures. The data will always be provided as an
-// interface{} and this will need to use reflection to determine how
-// to proceed. There are two primary paths here, `parseMap` and
-// `parseArray`, which will recursively traverse and convert the data
+// data for fixtures. The params are form encoded with the same encoder as
+// the params of --data-file, resolving the queries of string values and
+// leaving out null values.
 //
 // This returns an array of clean form data to make the request.
 func (fxt *Fixture) parseInterface(params interface{}) ([]string, error) {
-	var data []string
+	data, err := requests.FormEncoder{Resolve: fxt.parseQuery, SkipNulls: true}.Encode(params)
+	if err != nil {
+		return make([]string, 0), err
+	}
 
 	var cleanData []string
 
-	switch v := reflect.ValueOf(params); v.Kind() {
-	case reflect.Map:
-		m := params.(map[string]interface{})
-		parsed, err := fxt.parseMap(m, "", -1)
-		if err != nil {
-			return make([]string, 0), err
-		}
-		data = append(data, parsed...)
-	case reflect.Array:
-		a := params.([]interface{})
-		parsed, err := fxt.parseArray(a, "")
-		if err != nil {
-			return make([]string, 0), err
-		}
-		data = append(data, parsed...)
-	default:
-	}
-
 	for _, d := range data {
 		if strings.TrimSpace(d) != "" {
 			cleanData = append(cleanData, strings.TrimSpace(d))
@@ -113,140 +96,6 @@ func (fxt *Fixture) parseInterface(params interface{}) ([]string, error) {
 	return cleanData, nil
 }
 
-// parseMap recursively parses a map of string => interface{} until
-// each leaf node has a terminal type (String, Int, etc) that can no
-// longer be recursively traversed.
-func (fxt *Fixture) parseMap(params map[string]interface{}, parent string, index int) ([]string, error) {
-	data := make([]string, len(params))
-
-	var keyname string
-
-	for key, value := range params {
-		// Create the key name. As we start nesting deeper into the
-		// request data, we need to nest this with brackets,
-		// otherwise the data will be created at the wrong level.
-		switch {
-		case parent != "" && index >= 0:
-			// ex: lines[0][id] = "id_0000", lines[1][id] = "id_1234", etc.
-			keyname = fmt.Sprintf("%s[%d][%s]", parent, index, key)
-		case parent != "":
-			// ex: metadata[name] = "blah", metadata[timestamp] = 1231341525, etc.
-			keyname = fmt.Sprintf("%s[%s]", parent, key)
-		default:
-			keyname = key
-		}
-
-		// Check the type of the value for this pair. If this is a
-		// terminal type, append the data with the key. For maps and
-		// arrays, keep parsing.
-		switch v := reflect.ValueOf(value); v.Kind() {
-		case reflect.String:
-			// Strings can contain queries to load data from other
-			// responses, check and load those.
-			parsed, err := fxt.parseQuery(v.String())
-			if err != nil {
-				return make([]string, 0), err
-			}
-			data = append(data, fmt.Sprintf("%s=%s", keyname, parsed))
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			data = append(data, fmt.Sprintf("%s=%v", keyname, v.Int()))
-		case reflect.Float32, reflect.Float64:
-			/*
-				When converting fixture values to JSON, numeric values
-				reflect as float types. Thus in order to output the correct
-				value we should parse as such:
-
-				10 => 10
-				3.145 => 3.145
-				25.00 => 25
-				20.10 => 20.1
-
-				In order to preserve decimal places but strip them when
-				unnecessary (i.e 1.0), we must use strconv with the special
-				precision value of -1.
-
-				We cannot use %v here because it reverts to %g which uses
-				%e (scientific notation) for larger values otherwise %f
-				(float), which will not strip the decimal places from 4.00
-			*/
-			s64 := strconv.FormatFloat(v.Float(), 'f', -1, 64)
-			data = append(data, fmt.Sprintf("%s=%s", keyname, s64))
-		case reflect.Bool:
-			data = append(data, fmt.Sprintf("%s=%t", keyname, v.Bool()))
-		case reflect.Map:
-			m := value.(map[string]interface{})
-
-			result, err := fxt.parseMap(m, keyname, index)
-
-			if err != nil {
-				return make([]string, 0), err
-			}
-
-			data = append(data, result...)
-		case reflect.Array, reflect.Slice:
-			a := value.([]interface{})
-
-			result, err := fxt.parseArray(a, keyname)
-
-			if err != nil {
-				return make([]string, 0), err
-			}
-
-			data = append(data, result...)
-		// If for some reason we cannot parse the data, skip it
-		default:
-			continue
-		}
-	}
-
-	return data, nil
-}
-
-// parseArray is similar to parseMap but doesn't have to build the
-// multi-depth keys. Form data arrays contain brackets with nothing
-// inside the bracket to designate an array instead of a key value
-// pair.
-func (fxt *Fixture) parseArray(params []interface{}, parent string) ([]string, error) {
-	data := make([]string, len(params))
-
-	// The index is only used for arrays of maps
-	index := -1
-	for _, value := range params {
-		switch v := reflect.ValueOf(value); v.Kind() {
-		case reflect.String:
-			// A string can be a regular value or one we need to look up first, ex: ${product.id}
-			parsed, err := fxt.parseQuery(v.String())
-			if err != nil {
-				return make([]string, 0), err
-			}
-			data = append(data, fmt.Sprintf("%s[]=%s", parent, parsed))
-		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			data = append(data, fmt.Sprintf("%s[]=%v", parent, v.Int()))
-		case reflect.Map:
-			m := value.(map[string]interface{})
-			// When we parse arrays of maps, we want to track the index of the element for the request
-			// ex: lines[0][id] = "id_0000", lines[1][id] = "id_1234", etc.
-			index++
-			parsed, err := fxt.parseMap(m, parent, index)
-			if err != nil {
-				return make([]string, 0), err
-			}
-			data = append(data, parsed...)
-		case reflect.Array, reflect.Slice:
-			a := value.([]interface{})
-			parsed, err := fxt.parseArray(a, parent)
-			if err != nil {
-				return make([]string, 0), err
-			}
-			data = append(data, parsed...)
-		default:
-			continue
-		}
-	}
-
-	return data, nil
-}
-
 func normalizeForComparison(x string) string {
 	r := strings.NewReplacer("_", "", "-", "")
 	return r.Replace(strings.ToLower(x))
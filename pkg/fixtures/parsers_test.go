@@ -207,6 +207,31 @@ func TestParseInterface(t *testing.T) {
 	require.Equal(t, "tax_id_data[1][value]=value_1", output[7])
 }
 
+func TestParseNullsAndNestedLists(t *testing.T) {
+	fxt := Fixture{}
+	data := map[string]interface{}{
+		"description": nil,
+		"metadata":    map[string]interface{}{"order": nil, "plan": "pro"},
+		"expand":      []interface{}{[]interface{}{"customer"}, []interface{}{"invoice", nil}},
+		"line_items": []interface{}{
+			map[string]interface{}{"price_data": map[string]interface{}{"currency": "usd"}, "quantity": float64(2)},
+		},
+	}
+
+	output, err := fxt.parseInterface(data)
+	require.NoError(t, err)
+
+	// Null values are left out and the items of nested lists are sent as
+	// items of their parent list
+	require.Equal(t, []string{
+		"expand[]=customer",
+		"expand[]=invoice",
+		"line_items[0][price_data][currency]=usd",
+		"line_items[0][quantity]=2",
+		"metadata[plan]=pro",
+	}, output)
+}
+
 func TestParseWithQueryIgnoreDefault(t *testing.T) {
 	jsonData := gjson.Parse(`{"id": "cust_bend123456789", "currency": "eur"}`)
 
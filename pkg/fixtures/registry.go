@@ -0,0 +1,75 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+)
+
+// Besides the built-in triggers, the CLI discovers user-defined triggers
+// from the `triggers` directory of the config folder and from the
+// `.stripe/triggers` directory of the current project. Every JSON or YAML
+// fixture in those directories becomes a trigger named after the file,
+// e.g. `.stripe/triggers/acme.annual-upgrade.yaml` can be run with
+// `stripe trigger acme.annual-upgrade`.
+//
+// Project triggers take precedence over the ones in the config folder,
+// and both take precedence over the built-in triggers.
+
+// ProjectTriggersDir is the directory, relative to the current project,
+// that is searched for user-defined triggers
+const ProjectTriggersDir = ".stripe/triggers"
+
+// TriggerDirs returns the directories searched for user-defined triggers,
+// from lowest to highest precedence
+func TriggerDirs() []string {
+	dirs := []string{
+		filepath.Join(config.Folder(), "triggers"),
+	}
+
+	if wd, err := os.Getwd(); err == nil {
+		dirs = append(dirs, filepath.Join(wd, ProjectTriggersDir))
+	}
+
+	return dirs
+}
+
+// UserTriggers returns a mapping of user-defined trigger names to their
+// fixture files
+func UserTriggers(fs afero.Fs) map[string]string {
+	registry := make(map[string]string)
+
+	for _, dir := range TriggerDirs() {
+		files, err := afero.ReadDir(fs, dir)
+		if err != nil {
+			continue
+		}
+
+		for _, file := range files {
+			name := file.Name()
+			ext := strings.ToLower(filepath.Ext(name))
+			if file.IsDir() || (ext != ".json" && !isYAMLFile(name)) {
+				continue
+			}
+
+			registry[strings.TrimSuffix(name, filepath.Ext(name))] = filepath.Join(dir, name)
+		}
+	}
+
+	return registry
+}
+
+// TriggerFile returns the fixture file for the trigger with the given
+// name. User-defined triggers shadow the built-in ones.
+func TriggerFile(fs afero.Fs, event string) (string, bool) {
+	if file, ok := UserTriggers(fs)[event]; ok {
+		return file, true
+	}
+
+	file, ok := Events[event]
+	return file, ok
+}
@@ -0,0 +1,44 @@
+package fixtures
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserTriggers(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", "/xdg")
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	fs := afero.NewMemMapFs()
+	configDir := filepath.Join("/xdg", "stripe", "triggers")
+	projectDir := filepath.Join(wd, ProjectTriggersDir)
+
+	afero.WriteFile(fs, filepath.Join(configDir, "acme.annual-upgrade.json"), []byte(`{}`), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join(configDir, "acme.shared.yaml"), []byte(``), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join(configDir, "README.md"), []byte(``), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join(projectDir, "acme.shared.yml"), []byte(``), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join(projectDir, "customer.created.json"), []byte(`{}`), os.ModePerm)
+
+	assert.Equal(t, map[string]string{
+		"acme.annual-upgrade": filepath.Join(configDir, "acme.annual-upgrade.json"),
+		"acme.shared":         filepath.Join(projectDir, "acme.shared.yml"),
+		"customer.created":    filepath.Join(projectDir, "customer.created.json"),
+	}, UserTriggers(fs))
+
+	file, ok := TriggerFile(fs, "customer.created")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(projectDir, "customer.created.json"), file)
+
+	file, ok = TriggerFile(fs, "customer.updated")
+	assert.True(t, ok)
+	assert.Equal(t, Events["customer.updated"], file)
+
+	_, ok = TriggerFile(fs, "acme.unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,195 @@
+package fixtures
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// A fixture run report lists every step of the run with its duration, the
+// HTTP status and request ID of its last request, the ID of the object it
+// returned and its error. Reports can be written as JSON or as JUnit XML
+// so that CI systems can show fixture runs as test results.
+
+// Supported report formats
+const (
+	ReportFormatJSON  = "json"
+	ReportFormatJUnit = "junit"
+)
+
+// StepResult is the result of a single fixture step
+type StepResult struct {
+	Name      string        `json:"name"`
+	Duration  time.Duration `json:"-"`
+	Status    int           `json:"http_status,omitempty"`
+	RequestID string        `json:"request_id,omitempty"`
+	ObjectID  string        `json:"object_id,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Skipped   bool          `json:"skipped,omitempty"`
+}
+
+// MarshalJSON reports the duration in seconds
+func (r StepResult) MarshalJSON() ([]byte, error) {
+	type plainResult StepResult
+
+	return json.Marshal(struct {
+		plainResult
+		Duration float64 `json:"duration_seconds"`
+	}{plainResult(r), r.Duration.Seconds()})
+}
+
+// ReportFormatForFile returns the report format for a file name: JUnit for
+// `.xml` files and JSON otherwise
+func ReportFormatForFile(file string) string {
+	if strings.EqualFold(filepath.Ext(file), ".xml") {
+		return ReportFormatJUnit
+	}
+
+	return ReportFormatJSON
+}
+
+// Results returns the results of the steps executed so far
+func (fxt *Fixture) Results() []StepResult {
+	return fxt.results
+}
+
+// onResponse records the status and the request ID of the last response
+// received for the current step
+func (fxt *Fixture) onResponse(resp *http.Response) {
+	fxt.lastStatus = resp.StatusCode
+	fxt.lastRequestID = resp.Header.Get("Request-Id")
+}
+
+// recordStep appends the result of a step
+func (fxt *Fixture) recordStep(name string, start time.Time, stepErr error) {
+	result := StepResult{
+		Name:      name,
+		Duration:  time.Since(start),
+		Status:    fxt.lastStatus,
+		RequestID: fxt.lastRequestID,
+	}
+
+	if resp, ok := fxt.responses[name]; ok {
+		result.ObjectID = resp.Get("id").String()
+	}
+
+	if stepErr != nil {
+		result.Error = stepErr.Error()
+	}
+
+	fxt.results = append(fxt.results, result)
+}
+
+// recordSkippedStep appends the result of a step that was not executed
+func (fxt *Fixture) recordSkippedStep(name string) {
+	fxt.results = append(fxt.results, StepResult{Name: name, Skipped: true})
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteReport writes the results of a fixture run in the given format. The
+// name identifies the fixture, e.g. its file name.
+func WriteReport(w io.Writer, format, name string, results []StepResult) error {
+	switch format {
+	case ReportFormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(struct {
+			Fixture string       `json:"fixture"`
+			Steps   []StepResult `json:"steps"`
+		}{name, results})
+	case ReportFormatJUnit:
+		suite := junitTestSuite{Name: name, Tests: len(results)}
+
+		var total time.Duration
+		for _, result := range results {
+			total += result.Duration
+
+			testCase := junitTestCase{
+				Name:      result.Name,
+				ClassName: name,
+				Time:      formatSeconds(result.Duration),
+				SystemOut: describeStepResult(result),
+			}
+
+			switch {
+			case result.Skipped:
+				suite.Skipped++
+				testCase.Skipped = &struct{}{}
+			case result.Error != "":
+				suite.Failures++
+				testCase.Failure = &junitFailure{Message: result.Error, Text: result.Error}
+			}
+
+			suite.Cases = append(suite.Cases, testCase)
+		}
+		suite.Time = formatSeconds(total)
+
+		if _, err := io.WriteString(w, xml.Header); err != nil {
+			return err
+		}
+
+		encoder := xml.NewEncoder(w)
+		encoder.Indent("", "  ")
+		if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+			return err
+		}
+
+		_, err := io.WriteString(w, "\n")
+		return err
+	default:
+		return fmt.Errorf("unsupported report format: %s", format)
+	}
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// describeStepResult summarizes the request details of a step
+func describeStepResult(result StepResult) string {
+	var details []string
+
+	if result.Status != 0 {
+		details = append(details, fmt.Sprintf("http_status=%d", result.Status))
+	}
+	if result.RequestID != "" {
+		details = append(details, fmt.Sprintf("request_id=%s", result.RequestID))
+	}
+	if result.ObjectID != "" {
+		details = append(details, fmt.Sprintf("object_id=%s", result.ObjectID))
+	}
+
+	return strings.Join(details, " ")
+}
@@ -0,0 +1,187 @@
+package fixtures
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+)
+
+// A tracked run sends every POST request of a fixture with an idempotency
+// key derived from the fixture name, the step name and the run ID, and
+// saves its progress to a state file after every step. When a step fails,
+// the run can be resumed with `stripe fixtures --resume <run-id>`: the
+// completed steps are skipped and their saved responses are reused, so
+// that the objects they created are not created again. The failed step is
+// retried with a new idempotency key, since the API replays the response
+// of the failed request for the key it was sent with.
+//
+// The params of a run must be the same when it's resumed, so the run keeps
+// the time it was started at, which is sent as the `_created_by_fixture`
+// metadata, and the account and API version it was run with.
+//
+// The state file of a run is removed once all its steps have run.
+
+// RunState is the saved progress of a fixture run
+type RunState struct {
+	RunID         string                     `json:"run_id"`
+	File          string                     `json:"file"`
+	StartedAt     string                     `json:"started_at"`
+	StripeAccount string                     `json:"stripe_account,omitempty"`
+	APIVersion    string                     `json:"api_version,omitempty"`
+	Skip          []string                   `json:"skip,omitempty"`
+	Override      []string                   `json:"override,omitempty"`
+	Add           []string                   `json:"add,omitempty"`
+	Remove        []string                   `json:"remove,omitempty"`
+	Completed     []string                   `json:"completed"`
+	FailedStep    string                     `json:"failed_step,omitempty"`
+	Error         string                     `json:"error,omitempty"`
+	Attempts      map[string]int             `json:"attempts,omitempty"`
+	Responses     map[string]json.RawMessage `json:"responses"`
+}
+
+// NewRunID returns a new unique fixture run ID
+func NewRunID() string {
+	suffix := make([]byte, 4)
+	rand.Read(suffix)
+
+	return fmt.Sprintf("%s-%s", time.Now().UTC().Format("20060102T150405"), hex.EncodeToString(suffix))
+}
+
+// RunsDir returns the directory where the state of fixture runs is saved
+func RunsDir() string {
+	return filepath.Join(config.Folder(), "fixture_runs")
+}
+
+func runStateFile(runID string) string {
+	return filepath.Join(RunsDir(), runID+".json")
+}
+
+// LoadRunState loads the saved state of a fixture run
+func LoadRunState(fs afero.Fs, runID string) (*RunState, error) {
+	data, err := afero.ReadFile(fs, runStateFile(runID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no saved fixture run with ID %s", runID)
+		}
+		return nil, err
+	}
+
+	var state RunState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// CheckResume returns an error if a run would be resumed with another
+// account or API version than it was started with
+func (state *RunState) CheckResume(stripeAccount, apiVersion string) error {
+	if stripeAccount != state.StripeAccount {
+		return fmt.Errorf("fixture run %s was started with --stripe-account %q and must be resumed with the same account", state.RunID, state.StripeAccount)
+	}
+
+	if apiVersion != state.APIVersion {
+		return fmt.Errorf("fixture run %s was started with --api-version %q and must be resumed with the same API version", state.RunID, state.APIVersion)
+	}
+
+	return nil
+}
+
+// TrackRun enables idempotency keys and state saving for the fixture. The
+// steps already completed in the state are skipped when the fixture is
+// executed and their responses are available to the following steps.
+func (fxt *Fixture) TrackRun(state *RunState) {
+	if state.Responses == nil {
+		state.Responses = make(map[string]json.RawMessage)
+	}
+	if state.Attempts == nil {
+		state.Attempts = make(map[string]int)
+	}
+	if state.StartedAt == "" {
+		state.StartedAt = time.Now().String()
+	}
+
+	fxt.run = state
+	fxt.completed = make(map[string]bool)
+
+	for _, name := range state.Completed {
+		fxt.completed[name] = true
+	}
+
+	for name, raw := range state.Responses {
+		fxt.responses[name] = gjson.ParseBytes(raw)
+	}
+}
+
+// idempotencyKey returns the idempotency key of the requests of a step.
+// Steps retried after a failure get the number of their attempt as suffix.
+func (fxt *Fixture) idempotencyKey(step string) string {
+	base := filepath.Base(fxt.run.File)
+	name := strings.TrimSuffix(base, filepath.Ext(base))
+
+	key := fmt.Sprintf("fixture-%s-%s-%s", name, step, fxt.run.RunID)
+	if attempt := fxt.run.Attempts[step]; attempt > 0 {
+		key = fmt.Sprintf("%s-%d", key, attempt)
+	}
+
+	return key
+}
+
+// saveRun records the completion or the failure of a step in the state
+// file of the run
+func (fxt *Fixture) saveRun(step string, stepErr error) error {
+	if fxt.run == nil {
+		return nil
+	}
+
+	if stepErr != nil {
+		fxt.run.FailedStep = step
+		fxt.run.Error = stepErr.Error()
+		fxt.run.Attempts[step]++
+	} else {
+		fxt.run.Completed = append(fxt.run.Completed, step)
+		raw := fxt.responses[step].Raw
+		if raw == "" {
+			raw = "null"
+		}
+		fxt.run.Responses[step] = json.RawMessage(raw)
+		fxt.run.FailedStep = ""
+		fxt.run.Error = ""
+	}
+
+	data, err := json.MarshalIndent(fxt.run, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := fxt.Fs.MkdirAll(RunsDir(), 0700); err != nil {
+		return err
+	}
+
+	return afero.WriteFile(fxt.Fs, runStateFile(fxt.run.RunID), data, 0600)
+}
+
+// finishRun removes the state file of a run whose steps have all run
+func (fxt *Fixture) finishRun() error {
+	if fxt.run == nil {
+		return nil
+	}
+
+	err := fxt.Fs.Remove(runStateFile(fxt.run.RunID))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
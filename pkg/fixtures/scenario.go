@@ -0,0 +1,196 @@
+package fixtures
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/tidwall/gjson"
+)
+
+// A scenario chains several triggers and fixtures into a single
+// end-to-end flow:
+//
+//	name: churn
+//	vars:
+//	  email: jenny.rosen@example.com
+//	steps:
+//	  - trigger: customer.created
+//	    name: signup
+//	    override:
+//	      - customer:email=${vars:email}
+//	  - fixture: ./fixtures/trial.yaml
+//	    name: trial
+//	    add:
+//	      - subscription:customer=${signup.customer:id}
+//	  - trigger: invoice.payment_failed
+//
+// Each step runs either a trigger (built-in or user-defined) or a fixture
+// file, resolved relative to the scenario file. The `skip`, `override`,
+// `add` and `remove` lists work like the flags of `stripe trigger`.
+//
+// Steps share their data: the responses of every step are available to
+// the following steps as `${<step name>.<fixture name>:path}`, and the
+// scenario variables as `${vars:name}`. The step name defaults to the
+// trigger name or to the fixture file name without its extension.
+
+// scenarioVarsName is the name under which scenario variables are queried
+const scenarioVarsName = "vars"
+
+type scenarioFile struct {
+	Name  string                 `json:"name"`
+	Vars  map[string]interface{} `json:"vars"`
+	Steps []scenarioStep         `json:"steps"`
+}
+
+type scenarioStep struct {
+	Name     string   `json:"name"`
+	Trigger  string   `json:"trigger"`
+	Fixture  string   `json:"fixture"`
+	Skip     []string `json:"skip"`
+	Override []string `json:"override"`
+	Add      []string `json:"add"`
+	Remove   []string `json:"remove"`
+}
+
+// Scenario runs a sequence of triggers and fixtures that share their data
+type Scenario struct {
+	Fs            afero.Fs
+	APIKey        string
+	StripeAccount string
+	BaseURL       string
+	file          string
+	scenario      scenarioFile
+	responses     map[string]gjson.Result
+}
+
+// NewScenarioFromFile creates a scenario from a JSON or YAML file
+func NewScenarioFromFile(fs afero.Fs, apiKey, stripeAccount, baseURL, file string) (*Scenario, error) {
+	data, err := afero.ReadFile(fs, file)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := Scenario{
+		Fs:            fs,
+		APIKey:        apiKey,
+		StripeAccount: stripeAccount,
+		BaseURL:       baseURL,
+		file:          file,
+		responses:     make(map[string]gjson.Result),
+	}
+
+	if err := unmarshalJSONOrYAML(data, isYAMLFile(file), &sc.scenario); err != nil {
+		return nil, err
+	}
+
+	if err := sc.validate(); err != nil {
+		return nil, err
+	}
+
+	vars, err := json.Marshal(sc.scenario.Vars)
+	if err != nil {
+		return nil, err
+	}
+	sc.responses[scenarioVarsName] = gjson.ParseBytes(vars)
+
+	return &sc, nil
+}
+
+func (step scenarioStep) name() string {
+	if step.Name != "" {
+		return step.Name
+	}
+
+	if step.Trigger != "" {
+		return step.Trigger
+	}
+
+	base := filepath.Base(step.Fixture)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func (sc *Scenario) validate() error {
+	if len(sc.scenario.Steps) == 0 {
+		return fmt.Errorf("scenario %s has no steps", sc.file)
+	}
+
+	names := make(map[string]bool)
+	for i, step := range sc.scenario.Steps {
+		if (step.Trigger == "") == (step.Fixture == "") {
+			return fmt.Errorf("scenario step %d must have exactly one of trigger or fixture", i+1)
+		}
+
+		name := step.name()
+		if names[name] {
+			return fmt.Errorf("scenario step name %s is used more than once, set a unique name for each step", name)
+		}
+		names[name] = true
+	}
+
+	return nil
+}
+
+// stepFile returns the fixture file that a scenario step runs
+func (sc *Scenario) stepFile(step scenarioStep) (string, error) {
+	if step.Trigger != "" {
+		file, ok := TriggerFile(sc.Fs, step.Trigger)
+		if !ok {
+			return "", fmt.Errorf("The event ‘%s’ is not supported by the Stripe CLI.", step.Trigger)
+		}
+
+		return file, nil
+	}
+
+	if filepath.IsAbs(step.Fixture) {
+		return step.Fixture, nil
+	}
+
+	return filepath.Join(filepath.Dir(sc.file), step.Fixture), nil
+}
+
+// Execute runs every step of the scenario in order and returns the names
+// of the requests that were made, prefixed with their step name
+func (sc *Scenario) Execute(ctx context.Context, apiVersion string) ([]string, error) {
+	var requestNames []string
+
+	for _, step := range sc.scenario.Steps {
+		name := step.name()
+		fmt.Printf("Running scenario step: %s\n", name)
+
+		file, err := sc.stepFile(step)
+		if err != nil {
+			return nil, err
+		}
+
+		fxt, err := NewFixtureFromFile(sc.Fs, sc.APIKey, sc.StripeAccount, sc.BaseURL, file, step.Skip, step.Override, step.Add, step.Remove)
+		if err != nil {
+			return nil, fmt.Errorf("scenario step %s: %w", name, err)
+		}
+
+		// Give the step access to the data of the previous steps
+		for key, value := range sc.responses {
+			fxt.responses[key] = value
+		}
+
+		names, err := fxt.Execute(ctx, apiVersion)
+		if err != nil {
+			return nil, fmt.Errorf("scenario step %s: %w", name, err)
+		}
+
+		for _, requestName := range names {
+			if requestName == "" {
+				continue
+			}
+
+			qualified := name + namespaceSeparator + requestName
+			sc.responses[qualified] = fxt.responses[requestName]
+			requestNames = append(requestNames, qualified)
+		}
+	}
+
+	return requestNames, nil
+}
@@ -0,0 +1,88 @@
+package fixtures
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testScenario = `
+name: churn
+vars:
+  email: jenny.rosen@example.com
+steps:
+  - trigger: customer.created
+    name: signup
+    override:
+      - customer:email=${vars:email}
+  - fixture: trial.yaml
+    add:
+      - subscription:customer=${signup.customer:id}
+`
+
+const testScenarioFixture = `
+fixtures:
+  - name: subscription
+    path: /v1/subscriptions
+    method: post
+`
+
+func TestScenarioExecute(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("Failure with request body: %s", err)
+		}
+
+		switch url := req.URL.String(); url {
+		case customersPath:
+			require.True(t, strings.Contains(string(body), "email=jenny.rosen%40example.com"))
+			res.Write([]byte(`{"id": "cus_123"}`))
+		case "/v1/subscriptions":
+			require.True(t, strings.Contains(string(body), "customer=cus_123"))
+			res.Write([]byte(`{"id": "sub_123"}`))
+		default:
+			t.Errorf("Received an unexpected request URL: %s", req.URL.String())
+		}
+	}))
+
+	defer func() { ts.Close() }()
+
+	afero.WriteFile(fs, filepath.Join("scenarios", "churn.yaml"), []byte(testScenario), os.ModePerm)
+	afero.WriteFile(fs, filepath.Join("scenarios", "trial.yaml"), []byte(testScenarioFixture), os.ModePerm)
+
+	scenario, err := NewScenarioFromFile(fs, apiKey, "", ts.URL, filepath.Join("scenarios", "churn.yaml"))
+	require.NoError(t, err)
+
+	requestNames, err := scenario.Execute(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"signup.customer", "trial.subscription"}, requestNames)
+	assert.Equal(t, "sub_123", scenario.responses["trial.subscription"].Get("id").String())
+}
+
+func TestScenarioValidation(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	afero.WriteFile(fs, "empty.json", []byte(`{"steps": []}`), os.ModePerm)
+	afero.WriteFile(fs, "both.json", []byte(`{"steps": [{"trigger": "customer.created", "fixture": "a.json"}]}`), os.ModePerm)
+	afero.WriteFile(fs, "duplicate.json", []byte(`{"steps": [{"trigger": "customer.created"}, {"trigger": "customer.created"}]}`), os.ModePerm)
+
+	_, err := NewScenarioFromFile(fs, apiKey, "", "", "empty.json")
+	require.EqualError(t, err, "scenario empty.json has no steps")
+
+	_, err = NewScenarioFromFile(fs, apiKey, "", "", "both.json")
+	require.EqualError(t, err, "scenario step 1 must have exactly one of trigger or fixture")
+
+	_, err = NewScenarioFromFile(fs, apiKey, "", "", "duplicate.json")
+	require.EqualError(t, err, "scenario step name customer.created is used more than once, set a unique name for each step")
+}
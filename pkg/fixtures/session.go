@@ -0,0 +1,203 @@
+package fixtures
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stripe/stripe-cli/pkg/requests"
+)
+
+// ExportSession turns a session log recorded by the CLI (see
+// requests.SessionLogEnv) into a fixture file, returned as indented JSON.
+// Every recorded request becomes a step named after the object it
+// returned, and the IDs returned by earlier steps are rewritten as
+// `${name:id}` wherever later steps use them. GET requests are only
+// exported when includeGets is set.
+func ExportSession(r io.Reader, includeGets bool) ([]byte, error) {
+	var entries []requests.RecordedRequest
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var entry requests.RecordedRequest
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			return nil, fmt.Errorf("invalid session log entry: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var steps []generatedStep
+	// names maps the IDs returned by the exported steps to their step name
+	names := make(map[string]string)
+	counts := make(map[string]int)
+
+	for _, entry := range entries {
+		if entry.Method == http.MethodGet && !includeGets {
+			continue
+		}
+
+		base := strings.ReplaceAll(entry.Object, ".", "_")
+		if base == "" {
+			base = "step"
+		}
+		counts[base]++
+		name := base
+		if counts[base] > 1 {
+			name = fmt.Sprintf("%s_%d", base, counts[base])
+		}
+
+		params, err := unflattenParams(entry.Params)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", entry.Method, entry.Path, err)
+		}
+
+		step := generatedStep{
+			Name:   name,
+			Path:   rewriteIDs(entry.Path, names),
+			Method: strings.ToLower(entry.Method),
+		}
+		if len(params) > 0 {
+			step.Params = rewriteValue(params, func(value string) string {
+				return rewriteIDs(value, names)
+			}).(map[string]interface{})
+		}
+		steps = append(steps, step)
+
+		if _, ok := names[entry.ResponseID]; entry.ResponseID != "" && !ok {
+			names[entry.ResponseID] = name
+		}
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("the session log has no requests to export")
+	}
+
+	data, err := json.MarshalIndent(generatedFixtureFile{
+		Meta:     metaFixture{Version: SupportedVersions},
+		Fixtures: steps,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(data, '\n'), nil
+}
+
+// rewriteIDs replaces every known ID in value with a query on the step
+// that returned it
+func rewriteIDs(value string, names map[string]string) string {
+	if len(names) == 0 {
+		return value
+	}
+
+	ids := make([]string, 0, len(names))
+	for id := range names {
+		ids = append(ids, regexp.QuoteMeta(id))
+	}
+	// Longer IDs first, in case an ID is a prefix of another one
+	sort.Slice(ids, func(i, j int) bool { return len(ids[i]) > len(ids[j]) })
+
+	idsRegex := regexp.MustCompile(`\b(` + strings.Join(ids, "|") + `)\b`)
+
+	return idsRegex.ReplaceAllStringFunc(value, func(id string) string {
+		return fmt.Sprintf("${%s:id}", names[id])
+	})
+}
+
+// unflattenParams converts form encoded params (`a[b][0]=c`) back to the
+// nested params of a fixture step
+func unflattenParams(data []string) (map[string]interface{}, error) {
+	root := make(map[string]interface{})
+
+	for _, datum := range data {
+		split := strings.SplitN(datum, "=", 2)
+		if len(split) < 2 {
+			return nil, fmt.Errorf("invalid param: %s", datum)
+		}
+
+		keys := parseParamKey(split[0])
+		current := root
+		for i, key := range keys {
+			if key == "" {
+				// `a[]=b` appends to a list
+				key = strconv.Itoa(len(current))
+			}
+
+			if i == len(keys)-1 {
+				current[key] = split[1]
+				break
+			}
+
+			next, ok := current[key].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				current[key] = next
+			}
+			current = next
+		}
+	}
+
+	for key, value := range root {
+		root[key] = listsFromIndexedMaps(value)
+	}
+
+	return root, nil
+}
+
+// parseParamKey splits a form key such as `a[b][0]` into its components
+func parseParamKey(key string) []string {
+	i := strings.Index(key, "[")
+	if i < 0 {
+		return []string{key}
+	}
+
+	keys := []string{key[:i]}
+	for _, part := range strings.Split(strings.TrimSuffix(key[i+1:], "]"), "][") {
+		keys = append(keys, part)
+	}
+
+	return keys
+}
+
+// listsFromIndexedMaps converts the nested maps whose keys are all list
+// indexes into lists
+func listsFromIndexedMaps(value interface{}) interface{} {
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	for key, nested := range m {
+		m[key] = listsFromIndexedMaps(nested)
+	}
+
+	list := make([]interface{}, len(m))
+	for key, nested := range m {
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= len(m) {
+			return m
+		}
+		list[index] = nested
+	}
+
+	if len(list) == 0 {
+		return m
+	}
+
+	return list
+}
@@ -0,0 +1,60 @@
+package fixtures
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tidwall/gjson"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSessionLog = `
+{"method": "POST", "path": "/v1/customers", "params": ["email=jenny.rosen@example.com"], "response_id": "cus_123", "object": "customer"}
+{"method": "GET", "path": "/v1/customers/cus_123", "response_id": "cus_123", "object": "customer"}
+{"method": "POST", "path": "/v1/payment_intents", "params": ["customer=cus_123", "amount=2000", "currency=usd", "payment_method_types[0]=card", "metadata[order]=cus_1234"], "response_id": "pi_123", "object": "payment_intent"}
+{"method": "POST", "path": "/v1/payment_intents/pi_123/confirm", "params": ["payment_method=pm_card_visa"], "response_id": "pi_123", "object": "payment_intent"}
+`
+
+func TestExportSession(t *testing.T) {
+	data, err := ExportSession(strings.NewReader(testSessionLog), false)
+	require.NoError(t, err)
+
+	steps := gjson.GetBytes(data, "fixtures").Array()
+	require.Len(t, steps, 3)
+
+	assert.Equal(t, "customer", steps[0].Get("name").String())
+	assert.JSONEq(t, `{"email": "jenny.rosen@example.com"}`, steps[0].Get("params").Raw)
+
+	assert.Equal(t, "payment_intent", steps[1].Get("name").String())
+	assert.JSONEq(t, `{
+		"customer": "${customer:id}",
+		"amount": "2000",
+		"currency": "usd",
+		"payment_method_types": ["card"],
+		"metadata": {"order": "cus_1234"}
+	}`, steps[1].Get("params").Raw)
+
+	assert.Equal(t, "payment_intent_2", steps[2].Get("name").String())
+	assert.Equal(t, "/v1/payment_intents/${payment_intent:id}/confirm", steps[2].Get("path").String())
+
+	// The exported fixture can be loaded back
+	_, err = NewFixtureFromRawString(nil, apiKey, "", "", string(data))
+	require.NoError(t, err)
+}
+
+func TestExportSessionWithGets(t *testing.T) {
+	data, err := ExportSession(strings.NewReader(testSessionLog), true)
+	require.NoError(t, err)
+
+	steps := gjson.GetBytes(data, "fixtures").Array()
+	require.Len(t, steps, 4)
+	assert.Equal(t, "get", steps[1].Get("method").String())
+	assert.Equal(t, "/v1/customers/${customer:id}", steps[1].Get("path").String())
+}
+
+func TestExportEmptySession(t *testing.T) {
+	_, err := ExportSession(strings.NewReader(""), false)
+	require.EqualError(t, err, "the session log has no requests to export")
+}
@@ -0,0 +1,216 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// Test clock steps make it possible to fast-forward time in a fixture,
+// which is needed to reproduce subscription lifecycle events such as
+// renewals, trials ending or dunning:
+//
+//	{"name": "clock", "test_clock": {"frozen_time": "now"}},
+//	{
+//	  "name": "customer",
+//	  "path": "/v1/customers",
+//	  "method": "post",
+//	  "params": {"test_clock": "${clock:id}"}
+//	},
+//	...
+//	{"name": "renewal", "advance": {"test_clock": "${clock:id}", "by": "31d"}}
+//
+// A `test_clock` step creates a clock, frozen at the given unix timestamp
+// or at the current time when set to "now". Customers are attached to the
+// clock through their `test_clock` param. An `advance` step moves the
+// clock forward by a duration (Go duration syntax, with an additional `d`
+// unit for days) and waits until the clock is ready again.
+
+const testClocksPath = "/v1/test_helpers/test_clocks"
+
+type fixtureTestClock struct {
+	// FrozenTime is a unix timestamp or "now". Defaults to "now".
+	FrozenTime interface{} `json:"frozen_time,omitempty"`
+	Name       string      `json:"name,omitempty"`
+}
+
+type fixtureAdvance struct {
+	TestClock string `json:"test_clock"`
+	By        string `json:"by"`
+	Interval  string `json:"interval,omitempty"`
+	Timeout   string `json:"timeout,omitempty"`
+}
+
+var daysRegex = regexp.MustCompile(`^(\d+)d(.*)$`)
+
+// parseAdvanceDuration parses a Go duration that may be prefixed with a
+// number of days, e.g. "31d" or "1d12h"
+func parseAdvanceDuration(value string) (time.Duration, error) {
+	var duration time.Duration
+
+	if matches := daysRegex.FindStringSubmatch(value); matches != nil {
+		days, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return 0, err
+		}
+
+		duration = time.Duration(days) * 24 * time.Hour
+		value = matches[2]
+	}
+
+	if value != "" {
+		rest, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+
+		duration += rest
+	}
+
+	return duration, nil
+}
+
+func (tc fixtureTestClock) frozenTime() (int64, error) {
+	switch v := tc.FrozenTime.(type) {
+	case nil:
+		return time.Now().Unix(), nil
+	case float64:
+		return int64(v), nil
+	case string:
+		if v == "now" {
+			return time.Now().Unix(), nil
+		}
+
+		return strconv.ParseInt(v, 10, 64)
+	default:
+		return 0, fmt.Errorf("invalid test clock frozen_time: %v", v)
+	}
+}
+
+func (a fixtureAdvance) validate() error {
+	if a.TestClock == "" {
+		return fmt.Errorf("advance is missing a test_clock")
+	}
+
+	duration, err := parseAdvanceDuration(a.By)
+	if err != nil {
+		return fmt.Errorf("invalid advance duration: %w", err)
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("advance duration must be positive")
+	}
+
+	_, _, err = a.wait().durations()
+	return err
+}
+
+// wait returns the wait configuration used to poll the clock until it
+// has finished advancing
+func (a fixtureAdvance) wait() fixtureWait {
+	return fixtureWait{
+		Until:    `status == "ready"`,
+		Interval: a.Interval,
+		Timeout:  a.Timeout,
+	}
+}
+
+// validateTestClocks checks the configuration of every test clock and
+// advance step before any request is made
+func (fxt *Fixture) validateTestClocks() error {
+	for _, data := range fxt.fixture.Fixtures {
+		if data.TestClock != nil {
+			if _, err := data.TestClock.frozenTime(); err != nil {
+				return fmt.Errorf("%s: %w", data.Name, err)
+			}
+		}
+
+		if data.Advance != nil {
+			if err := data.Advance.validate(); err != nil {
+				return fmt.Errorf("%s: %w", data.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// createTestClock creates the test clock declared by a fixture step
+func (fxt *Fixture) createTestClock(ctx context.Context, data fixture, apiVersion string) ([]byte, error) {
+	frozenTime, err := data.TestClock.frozenTime()
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]interface{}{
+		"frozen_time": strconv.FormatInt(frozenTime, 10),
+	}
+	if data.TestClock.Name != "" {
+		params["name"] = data.TestClock.Name
+	}
+
+	return fxt.makeRequest(ctx, fixture{
+		Name:            data.Name,
+		Path:            testClocksPath,
+		Method:          "post",
+		Params:          params,
+		StripeAccount:   data.StripeAccount,
+		excludeMetadata: true,
+	}, apiVersion)
+}
+
+// advanceTestClock moves a test clock forward and waits until it is ready
+func (fxt *Fixture) advanceTestClock(ctx context.Context, data fixture, apiVersion string) ([]byte, error) {
+	clockID, err := fxt.parseQuery(data.Advance.TestClock)
+	if err != nil {
+		return nil, err
+	}
+
+	by, err := parseAdvanceDuration(data.Advance.By)
+	if err != nil {
+		return nil, err
+	}
+
+	clockPath := fmt.Sprintf("%s/%s", testClocksPath, clockID)
+
+	// Advancing is relative to the clock's current frozen time
+	resp, err := fxt.makeRequest(ctx, fixture{
+		Name:          data.Name,
+		Path:          clockPath,
+		Method:        http.MethodGet,
+		StripeAccount: data.StripeAccount,
+	}, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	frozenTime := gjson.GetBytes(resp, "frozen_time").Int() + int64(by.Seconds())
+	fmt.Fprintf(fxt.progress(), "Advancing test clock %s to %s\n", clockID, time.Unix(frozenTime, 0).UTC().Format(time.RFC3339))
+
+	_, err = fxt.makeRequest(ctx, fixture{
+		Name:   data.Name,
+		Path:   clockPath + "/advance",
+		Method: "post",
+		Params: map[string]interface{}{
+			"frozen_time": strconv.FormatInt(frozenTime, 10),
+		},
+		StripeAccount:   data.StripeAccount,
+		excludeMetadata: true,
+	}, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	wait := data.Advance.wait()
+	return fxt.waitFor(ctx, fixture{
+		Name:          data.Name,
+		Path:          clockPath,
+		Wait:          &wait,
+		StripeAccount: data.StripeAccount,
+	}, apiVersion)
+}
@@ -5,9 +5,11 @@ import (
 	"embed"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/spf13/afero"
 
+	"github.com/stripe/stripe-cli/pkg/proxy"
 	"github.com/stripe/stripe-cli/pkg/stripe"
 )
 
@@ -35,6 +37,7 @@ var Events = map[string]string{
 	"customer.source.updated":                  "triggers/customer.source.updated.json",
 	"customer.subscription.created":            "triggers/customer.subscription.created.json",
 	"customer.subscription.deleted":            "triggers/customer.subscription.deleted.json",
+	"customer.subscription.trial_will_end":     "triggers/customer.subscription.trial_will_end.json",
 	"customer.subscription.updated":            "triggers/customer.subscription.updated.json",
 	"invoice.created":                          "triggers/invoice.created.json",
 	"invoice.finalized":                        "triggers/invoice.finalized.json",
@@ -81,6 +84,15 @@ var Events = map[string]string{
 	"quote.accepted":                           "triggers/quote.accepted.json",
 }
 
+func init() {
+	// Hand-written triggers take precedence over the synthesized ones
+	for event, file := range generatedEvents {
+		if _, ok := Events[event]; !ok {
+			Events[event] = file
+		}
+	}
+}
+
 // BuildFromFixtureFile creates a new fixture struct for a file
 func BuildFromFixtureFile(fs afero.Fs, apiKey, stripeAccount, apiBaseURL, jsonFile string, skip, override, add, remove []string) (*Fixture, error) {
 	fixture, err := NewFixtureFromFile(
@@ -120,13 +132,20 @@ func EventList() string {
 	return eventList
 }
 
-// EventNames returns an array of all the event names
+// EventNames returns an array of all the event names, including the
+// user-defined triggers
 func EventNames() []string {
 	names := []string{}
 	for name := range Events {
 		names = append(names, name)
 	}
 
+	for name := range UserTriggers(afero.NewOsFs()) {
+		if _, ok := Events[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
 	sort.Strings(names)
 
 	return names
@@ -134,8 +153,55 @@ func EventNames() []string {
 
 // Trigger triggers a Stripe event.
 func Trigger(ctx context.Context, event string, stripeAccount string, baseURL string, apiKey string, skip, override, add, remove []string, raw string, apiVersion string) ([]string, error) {
-	var fixture *Fixture
-	var err error
+	fixture, err := buildTriggerFixture(ctx, event, stripeAccount, baseURL, apiKey, skip, override, add, remove, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	requestNames, err := fixture.Execute(ctx, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf(fmt.Sprintf("Trigger failed: %s\n", err))
+	}
+
+	return requestNames, nil
+}
+
+// TriggerAndWait triggers a Stripe event like Trigger, then waits until the
+// webhook events produced by the trigger are received through a listen
+// session, or until the timeout expires.
+func TriggerAndWait(ctx context.Context, event string, stripeAccount string, baseURL string, apiKey string, skip, override, add, remove []string, raw string, apiVersion string, deviceName string, timeout time.Duration) ([]string, []proxy.StripeEvent, error) {
+	fixture, err := buildTriggerFixture(ctx, event, stripeAccount, baseURL, apiKey, skip, override, add, remove, raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The listen session has to be ready before the trigger runs so that
+	// no event is missed
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	collector, err := ListenForEvents(ctx, deviceName, apiKey, baseURL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	requestNames, err := fixture.Execute(ctx, apiVersion)
+	if err != nil {
+		return nil, nil, fmt.Errorf(fmt.Sprintf("Trigger failed: %s\n", err))
+	}
+
+	expectedType := ""
+	if len(raw) == 0 {
+		if _, ok := Events[event]; ok {
+			expectedType = event
+		}
+	}
+
+	events, err := collector.Collect(ctx, fixture.ObjectIDs(), expectedType, timeout)
+	return requestNames, events, err
+}
+
+func buildTriggerFixture(ctx context.Context, event string, stripeAccount string, baseURL string, apiKey string, skip, override, add, remove []string, raw string) (*Fixture, error) {
 	fs := afero.NewOsFs()
 
 	// send event triggered
@@ -144,33 +210,32 @@ func Trigger(ctx context.Context, event string, stripeAccount string, baseURL st
 		go telemetryClient.SendEvent(ctx, "Triggered Event", event)
 	}
 
-	if len(raw) == 0 {
-		if file, ok := Events[event]; ok {
-			fixture, err = BuildFromFixtureFile(fs, apiKey, stripeAccount, baseURL, file, skip, override, add, remove)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			exists, _ := afero.Exists(fs, event)
-			if !exists {
-				return nil, fmt.Errorf(fmt.Sprintf("The event ‘%s’ is not supported by the Stripe CLI.", event))
-			}
-
-			fixture, err = BuildFromFixtureFile(fs, apiKey, stripeAccount, baseURL, event, skip, override, add, remove)
-			if err != nil {
-				return nil, err
-			}
-		}
-	} else {
-		fixture, err = BuildFromFixtureString(fs, apiKey, stripeAccount, baseURL, raw)
-		if err != nil {
-			return nil, err
-		}
+	if len(raw) != 0 {
+		return BuildFromFixtureString(fs, apiKey, stripeAccount, baseURL, raw)
 	}
 
-	requestNames, err := fixture.Execute(ctx, apiVersion)
+	if file, ok := TriggerFile(fs, event); ok {
+		return BuildFromFixtureFile(fs, apiKey, stripeAccount, baseURL, file, skip, override, add, remove)
+	}
+
+	exists, _ := afero.Exists(fs, event)
+	if !exists {
+		return nil, fmt.Errorf(fmt.Sprintf("The event ‘%s’ is not supported by the Stripe CLI.", event))
+	}
+
+	return BuildFromFixtureFile(fs, apiKey, stripeAccount, baseURL, event, skip, override, add, remove)
+}
+
+// TriggerScenario runs a scenario file chaining several triggers and fixtures.
+func TriggerScenario(ctx context.Context, file string, stripeAccount string, baseURL string, apiKey string, apiVersion string) ([]string, error) {
+	scenario, err := NewScenarioFromFile(afero.NewOsFs(), apiKey, stripeAccount, baseURL, file)
 	if err != nil {
-		return nil, fmt.Errorf(fmt.Sprintf("Trigger failed: %s\n", err))
+		return nil, err
+	}
+
+	requestNames, err := scenario.Execute(ctx, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf(fmt.Sprintf("Scenario failed: %s\n", err))
 	}
 
 	return requestNames, nil
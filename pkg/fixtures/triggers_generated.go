@@ -0,0 +1,7 @@
+// This file is generated; DO NOT EDIT.
+
+package fixtures
+
+// generatedEvents is a mapping of the events that don't have a hand-written
+// trigger to the fixtures synthesized for them from the OpenAPI spec
+var generatedEvents = map[string]string{}
@@ -0,0 +1,156 @@
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/tidwall/gjson"
+)
+
+// Wait steps poll an object until it reaches a given state, which is
+// needed for asynchronous flows such as report runs or payouts:
+//
+//	{
+//	  "name": "report_ready",
+//	  "path": "/v1/reporting/report_runs/${report_run:id}",
+//	  "wait": {
+//	    "until": "status == \"succeeded\"",
+//	    "interval": "2s",
+//	    "timeout": "5m"
+//	  }
+//	}
+//
+// The path is requested with GET until the `until` condition holds or the
+// timeout expires. The condition uses the same syntax as assertions. The
+// last response is stored under the step name like any other request.
+
+const (
+	defaultWaitInterval = 1 * time.Second
+	defaultWaitTimeout  = 60 * time.Second
+)
+
+type fixtureWait struct {
+	Until    string `json:"until"`
+	Interval string `json:"interval,omitempty"`
+	Timeout  string `json:"timeout,omitempty"`
+}
+
+// WaitTimeoutError is returned when the condition of a wait step did not
+// hold before its timeout expired
+type WaitTimeoutError struct {
+	Fixture   string
+	Condition string
+	Timeout   time.Duration
+	Actual    string
+}
+
+func (e WaitTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s waiting for %s: %s (actual: %s)", e.Timeout, e.Fixture, e.Condition, e.Actual)
+}
+
+func (w fixtureWait) durations() (time.Duration, time.Duration, error) {
+	interval := defaultWaitInterval
+	timeout := defaultWaitTimeout
+
+	var err error
+	if w.Interval != "" {
+		interval, err = time.ParseDuration(w.Interval)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid wait interval: %w", err)
+		}
+	}
+
+	if w.Timeout != "" {
+		timeout, err = time.ParseDuration(w.Timeout)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid wait timeout: %w", err)
+		}
+	}
+
+	if interval <= 0 || timeout <= 0 {
+		return 0, 0, fmt.Errorf("wait interval and timeout must be positive")
+	}
+
+	return interval, timeout, nil
+}
+
+func (w fixtureWait) validate() error {
+	if w.Until == "" {
+		return fmt.Errorf("wait is missing an until condition")
+	}
+
+	if _, err := parseAssertion(w.Until); err != nil {
+		return err
+	}
+
+	_, _, err := w.durations()
+	return err
+}
+
+// validateWaits checks the configuration of every wait step before any
+// request is made
+func (fxt *Fixture) validateWaits() error {
+	for _, data := range fxt.fixture.Fixtures {
+		if data.Wait == nil {
+			continue
+		}
+
+		if err := data.Wait.validate(); err != nil {
+			return fmt.Errorf("%s: %w", data.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// waitFor polls the path of a wait step until its condition holds and
+// returns the last response
+func (fxt *Fixture) waitFor(ctx context.Context, data fixture, apiVersion string) ([]byte, error) {
+	interval, timeout, err := data.Wait.durations()
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := parseAssertion(data.Wait.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Method = http.MethodGet
+	deadline := time.Now().Add(timeout)
+
+	for {
+		resp, err := fxt.makeRequest(ctx, data, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		ok, actual, err := fxt.evaluateAssertion(condition, gjson.ParseBytes(resp))
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return resp, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return nil, WaitTimeoutError{
+				Fixture:   data.Name,
+				Condition: data.Wait.Until,
+				Timeout:   timeout,
+				Actual:    actual,
+			}
+		}
+
+		fmt.Fprintf(fxt.progress(), "Waiting for %s: %s (actual: %s)\n", data.Name, data.Wait.Until, actual)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,133 @@
+//go:build gen_fixtures
+// +build gen_fixtures
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/stripe/stripe-cli/pkg/fixtures"
+	"github.com/stripe/stripe-cli/pkg/gen/synthesis"
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+type TemplateData struct {
+	Events []string
+}
+
+const (
+	pathStripeSpec = "../../api/openapi-spec/spec3.sdk.json"
+
+	pathTemplate = "../gen/triggers_generated.go.tpl"
+
+	pathName = "triggers_generated.go.tpl"
+
+	pathOutput = "../fixtures/triggers_generated.go"
+
+	pathFixtures = "../fixtures/triggers/generated"
+
+	generatedPrefix = "triggers/generated/"
+)
+
+func main() {
+	// generate a trigger fixture for every `.created` event that doesn't
+	// have a hand-written trigger, from the OpenAPI spec file. The fixtures
+	// are only written once they ran successfully against stripe-mock, so
+	// the triggers are left as they are when STRIPE_MOCK_URL isn't set.
+
+	baseURL := os.Getenv("STRIPE_MOCK_URL")
+	if baseURL == "" {
+		fmt.Println("STRIPE_MOCK_URL is not set, skipping the generation of trigger fixtures")
+		return
+	}
+
+	api, err := spec.LoadSpec(pathStripeSpec)
+	if err != nil {
+		panic(err)
+	}
+
+	// only skip the hand-written triggers, previously synthesized ones are
+	// generated again
+	exclude := make(map[string]string)
+	for event, file := range fixtures.Events {
+		if !strings.HasPrefix(file, generatedPrefix) {
+			exclude[event] = file
+		}
+	}
+
+	synthesized, err := synthesis.Synthesize(api, exclude)
+	if err != nil {
+		panic(err)
+	}
+
+	// the fixtures that stripe-mock rejects are reported and left out
+	synthesized, failures := synthesis.Validate(context.Background(), baseURL, synthesized)
+	for _, failure := range failures {
+		fmt.Fprintf(os.Stderr, "skipping %s\n", failure)
+	}
+
+	// write the fixtures, removing the ones of events that now have a
+	// hand-written trigger
+	err = os.RemoveAll(pathFixtures)
+	if err != nil {
+		panic(err)
+	}
+
+	// an empty directory can't be embedded
+	if len(synthesized) > 0 {
+		err = os.MkdirAll(pathFixtures, 0755)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	data := &TemplateData{}
+	for _, fxt := range synthesized {
+		content, err := json.MarshalIndent(fxt.File, "", "  ")
+		if err != nil {
+			panic(err)
+		}
+
+		path := filepath.Join(pathFixtures, fxt.Event+".json")
+		fmt.Printf("writing %s\n", path)
+		err = os.WriteFile(path, append(content, '\n'), 0644)
+		if err != nil {
+			panic(err)
+		}
+
+		data.Events = append(data.Events, fxt.Event)
+	}
+
+	// load template
+	tmpl := template.Must(template.
+		New(pathName).
+		ParseFiles(pathTemplate))
+
+	// execute template
+	var result bytes.Buffer
+	err = tmpl.Execute(&result, data)
+	if err != nil {
+		panic(err)
+	}
+
+	// format template output
+	formatted, err := format.Source(result.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	// write formatted code to disk
+	fmt.Printf("writing %s\n", pathOutput)
+	err = os.WriteFile(pathOutput, formatted, 0644)
+	if err != nil {
+		panic(err)
+	}
+}
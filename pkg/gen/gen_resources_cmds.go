@@ -34,7 +34,7 @@ type ResourceData struct {
 type OperationData struct {
 	Path      string
 	HTTPVerb  string
-	PropFlags map[string]string
+	PropFlags map[string]resource.Flag
 }
 
 const (
@@ -47,13 +47,6 @@ const (
 	pathOutput = "resources_cmds.go"
 )
 
-var scalarTypes = map[string]bool{
-	"boolean": true,
-	"integer": true,
-	"number":  true,
-	"string":  true,
-}
-
 var test_helpers_path = "test_helpers"
 
 func main() {
@@ -187,7 +180,7 @@ func addToTemplateData(data *TemplateData, nsName, resName, subResName string, s
 	// If we haven't seen the operation before, initialize it
 	if !operationExists {
 		httpString := string(op.Operation)
-		properties := make(map[string]string)
+		properties := make(map[string]resource.Flag)
 
 		specOp := stripeAPI.Paths[spec.Path(op.Path)][spec.HTTPVerb(httpString)]
 
@@ -201,13 +194,14 @@ func addToTemplateData(data *TemplateData, nsName, resName, subResName string, s
 
 			if media, ok := requestContent["application/x-www-form-urlencoded"]; ok {
 				for propName, schema := range media.Schema.Properties {
-					scalarType := getScalarType(schema)
+					flag := getFlag(schema, schema.Description)
 
-					if scalarType == nil {
+					if flag == nil {
 						continue
 					}
 
-					properties[propName] = *scalarType
+					flag.Required = isRequired(media.Schema.Required, propName)
+					properties[propName] = *flag
 				}
 			}
 		} else {
@@ -217,14 +211,14 @@ func addToTemplateData(data *TemplateData, nsName, resName, subResName string, s
 					continue
 				}
 
-				schema := param.Schema
-				scalarType := getScalarType(schema)
+				flag := getFlag(param.Schema, param.Description)
 
-				if scalarType == nil {
+				if flag == nil {
 					continue
 				}
 
-				properties[param.Name] = *scalarType
+				flag.Required = param.Required
+				properties[param.Name] = *flag
 			}
 		}
 
@@ -254,31 +248,38 @@ func parseSchemaName(name string) (string, string) {
 	return "", name
 }
 
-// getScalarType accepts a schema and returns its scalar type, if it has one.
-//
-// If the schema is monomorphic, it returns its type if it's scalar.
-//
-// If the schema is polymorphic, it returns the first scalar type for the
-// schema, if there is any.
-func getScalarType(schema *spec.Schema) *string {
-	if len(schema.AnyOf) > 0 {
-		for _, subSchema := range schema.AnyOf {
-			scalarType := getScalarType(subSchema)
-			if scalarType != nil {
-				return scalarType
-			}
-		}
-	} else if scalarTypes[schema.Type] {
-		// Special case for string types that only support the "" (empty
-		// string) value: we consider these to be non-scalar so we don't
-		// generate a flag for those.
-		if schema.Type == "string" {
-			if len(schema.Enum) == 1 && schema.Enum[0] == "" {
-				return nil
-			}
+// getFlag accepts a schema and returns the flag for it, if it can be set
+// with a flag.
+func getFlag(schema *spec.Schema, description string) *resource.Flag {
+	flag := resource.FlagFromSchema(schema)
+	if flag == nil {
+		return nil
+	}
+
+	flag.Description = firstSentence(description)
+
+	return flag
+}
+
+func isRequired(required []string, name string) bool {
+	for _, r := range required {
+		if r == name {
+			return true
 		}
-		return &schema.Type
 	}
 
-	return nil
+	return false
+}
+
+// firstSentence returns the first sentence of a description, without its
+// markup, to fit in the help of a flag
+func firstSentence(description string) string {
+	description = strings.Join(strings.Fields(description), " ")
+	description = strings.NewReplacer("`", "", "**", "").Replace(description)
+
+	if i := strings.Index(description, ". "); i >= 0 {
+		description = description[:i+1]
+	}
+
+	return description
 }
@@ -21,10 +21,10 @@ func addAllResourcesCmds(rootCmd *cobra.Command) {
 	r{{ (printf "%s_%s_%s" $nsName $resName $subResName) | ToCamel }}Cmd := resource.NewResourceCmd(r{{ (printf "%s_%s" $nsName $resName) | ToCamel }}Cmd.Cmd, "{{ $subResName }}"){{ end }}{{ end }}{{ end }}{{ end }}{{ end }}
 
 	// Operation commands{{ range $nsName, $nsData := .Namespaces }}{{ range $resName, $resData := $nsData.Resources }}{{ range $opName, $opData := $resData.Operations }}
-	resource.NewOperationCmd(r{{ (printf "%s_%s" $nsName $resName) | ToCamel }}Cmd.Cmd, "{{ $opName }}", "{{ $opData.Path }}", http.Method{{ $opData.HTTPVerb | ToCamel }}, map[string]string{ {{range $prop, $propType := $opData.PropFlags }}
-		"{{ $prop }}": "{{ $propType }}",{{ end }}
+	resource.NewOperationCmd(r{{ (printf "%s_%s" $nsName $resName) | ToCamel }}Cmd.Cmd, "{{ $opName }}", "{{ $opData.Path }}", http.Method{{ $opData.HTTPVerb | ToCamel }}, map[string]resource.Flag{ {{range $prop, $flag := $opData.PropFlags }}
+		"{{ $prop }}": { Type: "{{ $flag.Type }}"{{ if $flag.Description }}, Description: {{ printf "%q" $flag.Description }}{{ end }}{{ if $flag.Enum }}, Enum: []string{ {{ range $flag.Enum }}{{ printf "%q" . }}, {{ end }} }{{ end }}{{ if $flag.Required }}, Required: true{{ end }} },{{ end }}
 	}, &Config){{ end }}{{ range $subResName, $subResData := $resData.SubResources }}{{range $opName, $opData := $subResData.Operations }}
-	resource.NewOperationCmd(r{{ (printf "%s_%s_%s" $nsName $resName $subResName) | ToCamel }}Cmd.Cmd, "{{ $opName }}", "{{ $opData.Path }}", http.Method{{ $opData.HTTPVerb | ToCamel }}, map[string]string{ {{range $prop, $propType := $opData.PropFlags }}
-		"{{ $prop }}": "{{ $propType }}",{{ end }}
+	resource.NewOperationCmd(r{{ (printf "%s_%s_%s" $nsName $resName $subResName) | ToCamel }}Cmd.Cmd, "{{ $opName }}", "{{ $opData.Path }}", http.Method{{ $opData.HTTPVerb | ToCamel }}, map[string]resource.Flag{ {{range $prop, $flag := $opData.PropFlags }}
+		"{{ $prop }}": { Type: "{{ $flag.Type }}"{{ if $flag.Description }}, Description: {{ printf "%q" $flag.Description }}{{ end }}{{ if $flag.Enum }}, Enum: []string{ {{ range $flag.Enum }}{{ printf "%q" . }}, {{ end }} }{{ end }}{{ if $flag.Required }}, Required: true{{ end }} },{{ end }}
 	}, &Config){{ end }}{{ end }}{{ end }}{{ end }}
 }
@@ -0,0 +1,56 @@
+package synthesis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/spf13/afero"
+
+	"github.com/stripe/stripe-cli/pkg/fixtures"
+)
+
+// Synthesized fixtures are validated by running them against stripe-mock
+// before they are written. The tests of the synthesizer use a minimal mock
+// built from the test spec instead, which can't catch the params that the
+// API would reject since it's built from the same spec as the fixtures.
+
+// ValidationFailure describes a synthesized fixture that failed against the
+// server
+type ValidationFailure struct {
+	Event string
+	Err   error
+}
+
+func (f ValidationFailure) Error() string {
+	return fmt.Sprintf("%s: %s", f.Event, f.Err)
+}
+
+// Validate runs every fixture against the server at baseURL and returns
+// the fixtures that ran successfully and the failures of the other ones
+func Validate(ctx context.Context, baseURL string, synthesized []Fixture) ([]Fixture, []ValidationFailure) {
+	var valid []Fixture
+	var failures []ValidationFailure
+
+	for _, fxt := range synthesized {
+		raw, err := json.Marshal(fxt.File)
+		if err == nil {
+			var fixture *fixtures.Fixture
+			fixture, err = fixtures.NewFixtureFromRawString(afero.NewMemMapFs(), "sk_test_123", "", baseURL, string(raw))
+			if err == nil {
+				fixture.Progress = io.Discard
+				_, err = fixture.Execute(ctx, "")
+			}
+		}
+
+		if err != nil {
+			failures = append(failures, ValidationFailure{Event: fxt.Event, Err: err})
+			continue
+		}
+
+		valid = append(valid, fxt)
+	}
+
+	return valid, failures
+}
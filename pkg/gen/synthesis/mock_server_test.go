@@ -0,0 +1,155 @@
+package synthesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{[^}]+\}`)
+
+type mockRoute struct {
+	pattern   *regexp.Regexp
+	hasParams bool
+	schema    *spec.Schema
+	object    string
+}
+
+// MockServer is a minimal stripe-mock-style server for the tests of the
+// synthesizer. Create requests are validated against the spec: required params must be present, params
+// must be known and enum values valid. Every valid request returns an
+// object with a generated ID.
+type MockServer struct {
+	routes []mockRoute
+
+	mu    sync.Mutex
+	count int
+}
+
+// NewMockServer returns a mock server for the POST operations of the spec
+func NewMockServer(api *spec.Spec) *MockServer {
+	server := &MockServer{}
+
+	for name, schema := range api.Components.Schemas {
+		if schema.XStripeOperations == nil {
+			continue
+		}
+
+		for _, op := range *schema.XStripeOperations {
+			specOp := api.Paths[spec.Path(op.Path)][op.Operation]
+			if specOp == nil || specOp.RequestBody == nil {
+				continue
+			}
+
+			media, ok := specOp.RequestBody.Content[formContentType]
+			if !ok || media.Schema == nil {
+				continue
+			}
+
+			server.routes = append(server.routes, mockRoute{
+				pattern:   regexp.MustCompile("^" + pathParamRegex.ReplaceAllString(regexp.QuoteMeta(op.Path), `[^/]+`) + "$"),
+				hasParams: pathParamRegex.MatchString(op.Path),
+				schema:    media.Schema,
+				object:    name,
+			})
+		}
+	}
+
+	return server
+}
+
+// ServeHTTP handles a request as the Stripe API would, for POST requests
+func (m *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method != http.MethodPost {
+		writeMockError(w, http.StatusBadRequest, "", fmt.Sprintf("unsupported method %s", r.Method))
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeMockError(w, http.StatusBadRequest, "", err.Error())
+		return
+	}
+
+	// Routes without path params take precedence, e.g. `/v1/prices/search`
+	// over `/v1/prices/{price}`
+	var route *mockRoute
+	for i := range m.routes {
+		if !m.routes[i].pattern.MatchString(r.URL.Path) {
+			continue
+		}
+		if route == nil || !m.routes[i].hasParams {
+			route = &m.routes[i]
+		}
+	}
+	if route == nil {
+		writeMockError(w, http.StatusNotFound, "", fmt.Sprintf("unrecognized request URL (POST: %s)", r.URL.Path))
+		return
+	}
+
+	params := make(map[string][]string)
+	for key, values := range r.PostForm {
+		top := key
+		if i := strings.Index(key, "["); i >= 0 {
+			top = key[:i]
+		}
+		params[top] = append(params[top], values...)
+	}
+
+	for param, values := range params {
+		propSchema, ok := route.schema.Properties[param]
+		if !ok {
+			writeMockError(w, http.StatusBadRequest, param, fmt.Sprintf("Received unknown parameter: %s", param))
+			return
+		}
+
+		if len(propSchema.Enum) > 0 && !isEnumValue(propSchema.Enum, values[0]) {
+			writeMockError(w, http.StatusBadRequest, param, fmt.Sprintf("Invalid %s: must be one of %v", param, propSchema.Enum))
+			return
+		}
+	}
+
+	for _, required := range route.schema.Required {
+		if _, ok := params[required]; !ok {
+			writeMockError(w, http.StatusBadRequest, required, fmt.Sprintf("Missing required param: %s.", required))
+			return
+		}
+	}
+
+	m.mu.Lock()
+	m.count++
+	id := fmt.Sprintf("%s_mock%d", strings.ReplaceAll(route.object, ".", "_"), m.count)
+	m.mu.Unlock()
+
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"id":     id,
+		"object": route.object,
+	})
+}
+
+func isEnumValue(enum []interface{}, value string) bool {
+	for _, e := range enum {
+		if fmt.Sprint(e) == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func writeMockError(w http.ResponseWriter, status int, param, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]interface{}{
+			"type":    "invalid_request_error",
+			"param":   param,
+			"message": message,
+		},
+	})
+}
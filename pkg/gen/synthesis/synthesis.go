@@ -0,0 +1,383 @@
+// Package synthesis synthesizes minimal trigger fixtures from the OpenAPI
+// spec, for the events that don't have a hand-written trigger.
+//
+// For every resource with a top-level `create` operation, a fixture is
+// synthesized that creates the resource with the smallest set of params
+// the spec requires. Params that reference another creatable resource
+// (e.g. `customer` or `product`) are satisfied by a preceding step that
+// creates that resource, referenced as `${customer:id}`. The fixture is
+// registered as the trigger of the resource's `.created` event.
+package synthesis
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+const formContentType = "application/x-www-form-urlencoded"
+
+// maxDependencyDepth bounds how many resources are chained to satisfy the
+// references of a fixture
+const maxDependencyDepth = 4
+
+const createdDescription = "(created by Stripe CLI)"
+
+// Fixture is a synthesized fixture for a given event
+type Fixture struct {
+	Event    string
+	Resource string
+	File     FixtureFile
+}
+
+// FixtureFile is the fixture file format used by pkg/fixtures
+type FixtureFile struct {
+	Meta     FixtureMeta `json:"_meta"`
+	Fixtures []Step      `json:"fixtures"`
+}
+
+// FixtureMeta is the `_meta` section of a fixture file
+type FixtureMeta struct {
+	Version         int  `json:"template_version"`
+	ExcludeMetadata bool `json:"exclude_metadata,omitempty"`
+}
+
+// Step is a single request of a fixture file
+type Step struct {
+	Name   string                 `json:"name"`
+	Path   string                 `json:"path"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// creatable is a resource that has a top-level create operation
+type creatable struct {
+	name   string
+	path   string
+	schema *spec.Schema
+}
+
+type synthesizer struct {
+	api        *spec.Spec
+	resources  map[string]creatable
+	references map[string][]string
+}
+
+// Synthesize returns a fixture for the `.created` event of every resource
+// that has a top-level create operation, skipping the events in exclude
+// (typically the ones that already have a trigger). Fixtures are sorted by
+// event name.
+func Synthesize(api *spec.Spec, exclude map[string]string) ([]Fixture, error) {
+	s := synthesizer{
+		api:        api,
+		resources:  make(map[string]creatable),
+		references: make(map[string][]string),
+	}
+
+	for name, schema := range api.Components.Schemas {
+		if schema.XStripeOperations == nil {
+			continue
+		}
+
+		for _, op := range *schema.XStripeOperations {
+			if op.MethodName != "create" || op.MethodOn != "service" || strings.ToUpper(string(op.Operation)) != http.MethodPost {
+				continue
+			}
+
+			// Only top-level resources can be created without a parent
+			if strings.Contains(op.Path, "{") || strings.Contains(op.Path, "test_helpers") {
+				continue
+			}
+
+			specOp := api.Paths[spec.Path(op.Path)][op.Operation]
+			if specOp == nil || specOp.RequestBody == nil || (specOp.Deprecated != nil && *specOp.Deprecated) {
+				continue
+			}
+
+			media, ok := specOp.RequestBody.Content[formContentType]
+			if !ok || media.Schema == nil {
+				continue
+			}
+
+			s.resources[name] = creatable{name: name, path: op.Path, schema: media.Schema}
+
+			// Params reference resources by the last component of their
+			// name, e.g. `cardholder` for `issuing.cardholder`
+			short := name[strings.LastIndex(name, ".")+1:]
+			s.references[short] = append(s.references[short], name)
+		}
+	}
+
+	events := eventTypes(api)
+
+	var names []string
+	for name := range s.resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var synthesized []Fixture
+	for _, name := range names {
+		event, ok := createdEvent(name, events)
+		if !ok {
+			continue
+		}
+		if _, ok := exclude[event]; ok {
+			continue
+		}
+
+		file, err := s.fixtureFile(name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		synthesized = append(synthesized, Fixture{Event: event, Resource: name, File: file})
+	}
+
+	sort.Slice(synthesized, func(i, j int) bool { return synthesized[i].Event < synthesized[j].Event })
+
+	return synthesized, nil
+}
+
+// eventTypes returns the event types that webhook endpoints can subscribe to
+func eventTypes(api *spec.Spec) map[string]bool {
+	events := make(map[string]bool)
+
+	op := api.Paths["/v1/webhook_endpoints"]["post"]
+	if op == nil || op.RequestBody == nil {
+		return events
+	}
+
+	media, ok := op.RequestBody.Content[formContentType]
+	if !ok || media.Schema == nil || media.Schema.Properties["enabled_events"] == nil || media.Schema.Properties["enabled_events"].Items == nil {
+		return events
+	}
+
+	for _, e := range media.Schema.Properties["enabled_events"].Items.Enum {
+		if event, ok := e.(string); ok {
+			events[event] = true
+		}
+	}
+
+	return events
+}
+
+// createdEvent returns the `.created` event of a resource. Namespaced
+// resources use either their full name (`checkout.session.created`) or an
+// underscore (`issuing_card.created`) depending on the resource.
+func createdEvent(name string, events map[string]bool) (string, bool) {
+	for _, candidate := range []string{name, strings.ReplaceAll(name, ".", "_")} {
+		if event := candidate + ".created"; events[event] {
+			return event, true
+		}
+	}
+
+	return "", false
+}
+
+// fixtureFile builds the fixture creating a resource and its dependencies
+func (s *synthesizer) fixtureFile(name string) (FixtureFile, error) {
+	var steps []Step
+	if _, err := s.addStep(name, &steps, map[string]bool{}); err != nil {
+		return FixtureFile{}, err
+	}
+
+	return FixtureFile{
+		Meta: FixtureMeta{
+			// Fixtures tag the objects they create through their metadata,
+			// which not every resource supports
+			ExcludeMetadata: !s.allSupportMetadata(steps),
+		},
+		Fixtures: steps,
+	}, nil
+}
+
+func (s *synthesizer) allSupportMetadata(steps []Step) bool {
+	for _, step := range steps {
+		for _, res := range s.resources {
+			if res.path == step.Path && res.schema.Properties["metadata"] == nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// addStep appends the steps creating a resource, after the ones creating
+// its dependencies, and returns the name of its step
+func (s *synthesizer) addStep(name string, steps *[]Step, visiting map[string]bool) (string, error) {
+	stepName := strings.ReplaceAll(name, ".", "_")
+
+	for _, step := range *steps {
+		if step.Name == stepName {
+			return stepName, nil
+		}
+	}
+
+	if visiting[name] || len(visiting) >= maxDependencyDepth {
+		return "", fmt.Errorf("cannot synthesize the dependencies of %s", name)
+	}
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	res := s.resources[name]
+	params := make(map[string]interface{})
+
+	for _, prop := range sortedRequired(res.schema) {
+		propSchema := res.schema.Properties[prop]
+		if propSchema == nil {
+			continue
+		}
+
+		if dependency, ok := s.reference(name, prop); ok {
+			depStep, err := s.addStep(dependency, steps, visiting)
+			if err != nil {
+				return "", err
+			}
+
+			params[prop] = fmt.Sprintf("${%s:id}", depStep)
+			continue
+		}
+
+		if value := s.value(prop, propSchema, 0); value != nil {
+			params[prop] = value
+		}
+	}
+
+	if _, ok := params["description"]; !ok && res.schema.Properties["description"] != nil && isType(res.schema.Properties["description"], spec.TypeString) {
+		params["description"] = createdDescription
+	}
+
+	*steps = append(*steps, Step{
+		Name:   stepName,
+		Path:   res.path,
+		Method: "post",
+		Params: params,
+	})
+
+	return stepName, nil
+}
+
+// reference returns the resource referenced by a param, preferring the
+// resources of the same namespace
+func (s *synthesizer) reference(resource, param string) (string, bool) {
+	candidates := s.references[param]
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	namespace := ""
+	if i := strings.LastIndex(resource, "."); i >= 0 {
+		namespace = resource[:i+1]
+	}
+
+	for _, candidate := range candidates {
+		if candidate == namespace+param {
+			return candidate, candidate != resource
+		}
+	}
+
+	for _, candidate := range candidates {
+		if candidate == param {
+			return candidate, candidate != resource
+		}
+	}
+
+	return "", false
+}
+
+// value synthesizes a minimal value for a required param
+func (s *synthesizer) value(name string, schema *spec.Schema, depth int) interface{} {
+	if depth > maxDependencyDepth {
+		return nil
+	}
+
+	if len(schema.AnyOf) > 0 {
+		for _, sub := range schema.AnyOf {
+			if value := s.value(name, sub, depth); value != nil {
+				return value
+			}
+		}
+		return nil
+	}
+
+	for _, e := range schema.Enum {
+		if str, ok := e.(string); ok && str != "" {
+			return str
+		}
+	}
+	if len(schema.Enum) > 0 {
+		return nil
+	}
+
+	switch schema.Type {
+	case spec.TypeString:
+		return stringValue(name)
+	case spec.TypeInteger, spec.TypeNumber:
+		if strings.Contains(name, "amount") {
+			return 1000
+		}
+		return 1
+	case spec.TypeBoolean:
+		return true
+	case spec.TypeArray:
+		if schema.Items == nil {
+			return nil
+		}
+		if value := s.value(name, schema.Items, depth+1); value != nil {
+			return []interface{}{value}
+		}
+		return nil
+	case spec.TypeObject:
+		object := make(map[string]interface{})
+		for _, prop := range sortedRequired(schema) {
+			if propSchema := schema.Properties[prop]; propSchema != nil {
+				if value := s.value(prop, propSchema, depth+1); value != nil {
+					object[prop] = value
+				}
+			}
+		}
+		return object
+	}
+
+	return nil
+}
+
+func stringValue(name string) string {
+	switch {
+	case name == "currency":
+		return "usd"
+	case name == "country":
+		return "US"
+	case strings.Contains(name, "email"):
+		return "jenny.rosen@example.com"
+	case strings.HasSuffix(name, "url"):
+		return "https://example.com"
+	default:
+		return createdDescription
+	}
+}
+
+func isType(schema *spec.Schema, typ string) bool {
+	if schema.Type == typ {
+		return true
+	}
+
+	for _, sub := range schema.AnyOf {
+		if isType(sub, typ) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedRequired(schema *spec.Schema) []string {
+	required := append([]string{}, schema.Required...)
+	sort.Strings(required)
+	return required
+}
@@ -0,0 +1,234 @@
+package synthesis
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+const testSpec = `{
+	"components": {
+		"schemas": {
+			"customer": {
+				"x-stripeOperations": [
+					{"method_name": "create", "method_on": "service", "method_type": "create", "operation": "post", "path": "/v1/customers"}
+				]
+			},
+			"product": {
+				"x-stripeOperations": [
+					{"method_name": "create", "method_on": "service", "method_type": "create", "operation": "post", "path": "/v1/products"}
+				]
+			},
+			"price": {
+				"x-stripeOperations": [
+					{"method_name": "create", "method_on": "service", "method_type": "create", "operation": "post", "path": "/v1/prices"}
+				]
+			},
+			"issuing.cardholder": {
+				"x-stripeOperations": [
+					{"method_name": "create", "method_on": "service", "method_type": "create", "operation": "post", "path": "/v1/issuing/cardholders"}
+				]
+			},
+			"issuing.card": {
+				"x-stripeOperations": [
+					{"method_name": "create", "method_on": "service", "method_type": "create", "operation": "post", "path": "/v1/issuing/cards"}
+				]
+			}
+		}
+	},
+	"paths": {
+		"/v1/customers": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"properties": {
+				"description": {"type": "string"},
+				"email": {"type": "string"},
+				"metadata": {"anyOf": [{"type": "object", "additionalProperties": {"type": "string"}}, {"type": "string", "enum": [""]}]}
+			}
+		}}}}}},
+		"/v1/products": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"required": ["name"],
+			"properties": {
+				"name": {"type": "string"},
+				"description": {"type": "string"},
+				"metadata": {"type": "object"}
+			}
+		}}}}}},
+		"/v1/prices": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"required": ["currency", "product", "unit_amount"],
+			"properties": {
+				"currency": {"type": "string"},
+				"product": {"type": "string"},
+				"unit_amount": {"type": "integer"},
+				"billing_scheme": {"type": "string", "enum": ["per_unit", "tiered"]},
+				"metadata": {"type": "object"}
+			}
+		}}}}}},
+		"/v1/issuing/cardholders": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"required": ["billing", "name", "type"],
+			"properties": {
+				"billing": {"type": "object", "required": ["address"], "properties": {
+					"address": {"type": "object", "required": ["city", "country", "line1"], "properties": {
+						"city": {"type": "string"},
+						"country": {"type": "string"},
+						"line1": {"type": "string"}
+					}}
+				}},
+				"name": {"type": "string"},
+				"type": {"type": "string", "enum": ["company", "individual"]}
+			}
+		}}}}}},
+		"/v1/issuing/cards": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"required": ["cardholder", "currency", "type"],
+			"properties": {
+				"cardholder": {"type": "string"},
+				"currency": {"type": "string"},
+				"type": {"type": "string", "enum": ["physical", "virtual"]}
+			}
+		}}}}}},
+		"/v1/webhook_endpoints": {"post": {"requestBody": {"content": {"application/x-www-form-urlencoded": {"schema": {
+			"type": "object",
+			"properties": {
+				"enabled_events": {"type": "array", "items": {"type": "string", "enum": [
+					"customer.created", "issuing_card.created", "issuing_cardholder.created", "price.created", "product.created"
+				]}}
+			}
+		}}}}}}
+	}
+}`
+
+func loadTestSpec(t *testing.T) *spec.Spec {
+	var api spec.Spec
+	require.NoError(t, json.Unmarshal([]byte(testSpec), &api))
+	return &api
+}
+
+func TestSynthesize(t *testing.T) {
+	api := loadTestSpec(t)
+
+	synthesized, err := Synthesize(api, map[string]string{"customer.created": "triggers/customer.created.json"})
+	require.NoError(t, err)
+
+	var events []string
+	for _, fxt := range synthesized {
+		events = append(events, fxt.Event)
+	}
+	require.Equal(t, []string{"issuing_card.created", "issuing_cardholder.created", "price.created", "product.created"}, events)
+
+	card := synthesized[0].File
+	require.Len(t, card.Fixtures, 2)
+	assert.True(t, card.Meta.ExcludeMetadata)
+	assert.Equal(t, "issuing_cardholder", card.Fixtures[0].Name)
+	assert.Equal(t, map[string]interface{}{
+		"billing": map[string]interface{}{
+			"address": map[string]interface{}{
+				"city":    "(created by Stripe CLI)",
+				"country": "US",
+				"line1":   "(created by Stripe CLI)",
+			},
+		},
+		"name": "(created by Stripe CLI)",
+		"type": "company",
+	}, card.Fixtures[0].Params)
+	assert.Equal(t, map[string]interface{}{
+		"cardholder": "${issuing_cardholder:id}",
+		"currency":   "usd",
+		"type":       "physical",
+	}, card.Fixtures[1].Params)
+
+	price := synthesized[2].File
+	require.Len(t, price.Fixtures, 2)
+	assert.False(t, price.Meta.ExcludeMetadata)
+	assert.Equal(t, "/v1/products", price.Fixtures[0].Path)
+	assert.Equal(t, map[string]interface{}{
+		"currency":    "usd",
+		"product":     "${product:id}",
+		"unit_amount": 1000,
+	}, price.Fixtures[1].Params)
+}
+
+func TestValidateSynthesizedFixtures(t *testing.T) {
+	api := loadTestSpec(t)
+
+	synthesized, err := Synthesize(api, nil)
+	require.NoError(t, err)
+	require.Len(t, synthesized, 5)
+
+	ts := httptest.NewServer(NewMockServer(api))
+	defer ts.Close()
+
+	valid, failures := Validate(context.Background(), ts.URL, synthesized)
+	require.Empty(t, failures)
+	require.Equal(t, synthesized, valid)
+}
+
+func TestValidateReportsInvalidFixtures(t *testing.T) {
+	api := loadTestSpec(t)
+
+	ts := httptest.NewServer(NewMockServer(api))
+	defer ts.Close()
+
+	synthesized, err := Synthesize(api, nil)
+	require.NoError(t, err)
+
+	invalid := Fixture{
+		Event: "product.created",
+		File: FixtureFile{Fixtures: []Step{{
+			Name:   "product",
+			Path:   "/v1/products",
+			Method: "post",
+			Params: map[string]interface{}{"description": "missing name"},
+		}}},
+	}
+
+	// The invalid fixture is reported and the other ones are kept
+	valid, failures := Validate(context.Background(), ts.URL, append([]Fixture{invalid}, synthesized...))
+	require.Equal(t, synthesized, valid)
+	require.Len(t, failures, 1)
+	require.Equal(t, "product.created", failures[0].Event)
+	require.Contains(t, failures[0].Error(), "product.created: ")
+}
+
+func TestMockServer(t *testing.T) {
+	ts := httptest.NewServer(NewMockServer(loadTestSpec(t)))
+	defer ts.Close()
+
+	post := func(path string, form url.Values) (int, map[string]interface{}) {
+		resp, err := http.Post(ts.URL+path, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+		return resp.StatusCode, body
+	}
+
+	status, body := post("/v1/prices", url.Values{"currency": {"usd"}, "product": {"prod_123"}, "unit_amount": {"100"}})
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "price", body["object"])
+	require.NotEmpty(t, body["id"])
+
+	status, _ = post("/v1/prices", url.Values{"currency": {"usd"}, "product": {"prod_123"}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = post("/v1/prices", url.Values{"currency": {"usd"}, "product": {"prod_123"}, "unit_amount": {"100"}, "billing_scheme": {"flat"}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = post("/v1/products", url.Values{"name": {"shirt"}, "colour": {"red"}})
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = post("/v1/unknown", url.Values{})
+	require.Equal(t, http.StatusNotFound, status)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -750,3 +751,15 @@ func getAPIVersionString(str *string) string {
 
 	return APIVersion
 }
+
+// EventTypes returns the types of the webhook events that can be listened
+// for, sorted
+func EventTypes() []string {
+	types := make([]string, 0, len(validEvents))
+	for event := range validEvents {
+		types = append(types, event)
+	}
+	sort.Strings(types)
+
+	return types
+}
@@ -24,6 +24,7 @@ import (
 // RequestParameters captures the structure of the parameters that can be sent to Stripe
 type RequestParameters struct {
 	data          []string
+	dataFile      string
 	expand        []string
 	startingAfter string
 	endingBefore  string
@@ -38,6 +39,11 @@ func (r *RequestParameters) AppendData(data []string) {
 	r.data = append(r.data, data...)
 }
 
+// Data returns the params of the request, such as `metadata[plan]=pro`.
+func (r *RequestParameters) Data() []string {
+	return r.data
+}
+
 // AppendExpand appends fields to the expand parameter.
 func (r *RequestParameters) AppendExpand(fields []string) {
 	r.expand = append(r.expand, fields...)
@@ -101,8 +107,18 @@ type Base struct {
 
 	Livemode bool
 
-	autoConfirm bool
-	showHeaders bool
+	// OnResponse is called with the response of every request, before its
+	// body is read. It is used by fixtures to report the status and the
+	// request ID of each step.
+	OnResponse func(resp *http.Response)
+
+	autoConfirm    bool
+	showHeaders    bool
+	skipValidation bool
+
+	pagination pagination
+	output     output
+	bulk       bulk
 }
 
 var confirmationCommands = map[string]bool{http.MethodDelete: true}
@@ -135,6 +151,22 @@ func (rb *Base) RunRequestsCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if err := rb.LoadData(&rb.Parameters); err != nil {
+		return err
+	}
+
+	if rb.Bulk() {
+		return rb.MakeBulkRequests(cmd.Context(), apiKey, path, &rb.Parameters)
+	}
+
+	if err := rb.ValidateParams(path, &rb.Parameters); err != nil {
+		return err
+	}
+
+	if rb.Paginating() {
+		return rb.MakePaginatedRequest(cmd.Context(), apiKey, path, &rb.Parameters)
+	}
+
 	_, err = rb.MakeRequest(cmd.Context(), apiKey, path, &rb.Parameters, false)
 
 	return err
@@ -146,7 +178,8 @@ func (rb *Base) InitFlags() {
 		rb.Cmd.Flags().BoolVarP(&rb.autoConfirm, "confirm", "c", false, "Skip the warning prompt and automatically confirm the command being entered")
 	}
 
-	rb.Cmd.Flags().StringArrayVarP(&rb.Parameters.data, "data", "d", []string{}, "Data for the API request")
+	rb.Cmd.Flags().StringArrayVarP(&rb.Parameters.data, "data", "d", []string{}, "Data for the API request. Use - to read the params from stdin as JSON or YAML")
+	rb.Cmd.Flags().StringVar(&rb.Parameters.dataFile, "data-file", "", "JSON or YAML file with the params of the API request")
 	rb.Cmd.Flags().StringArrayVarP(&rb.Parameters.expand, "expand", "e", []string{}, "Response attributes to expand inline")
 	rb.Cmd.Flags().StringVarP(&rb.Parameters.idempotency, "idempotency", "i", "", "Set the idempotency key for the request, prevents replaying the same requests within 24 hours")
 	rb.Cmd.Flags().StringVarP(&rb.Parameters.version, "stripe-version", "v", "", "Set the Stripe API version to use for your request")
@@ -154,6 +187,7 @@ func (rb *Base) InitFlags() {
 	rb.Cmd.Flags().BoolVarP(&rb.showHeaders, "show-headers", "s", false, "Show response headers")
 	rb.Cmd.Flags().BoolVar(&rb.Livemode, "live", false, "Make a live request (default: test)")
 	rb.Cmd.Flags().BoolVar(&rb.DarkStyle, "dark-style", false, "Use a darker color scheme better suited for lighter command-lines")
+	rb.Cmd.Flags().BoolVar(&rb.skipValidation, "skip-validation", false, fmt.Sprintf("Skip the validation of params against the OpenAPI spec. Params are only validated when %s is set to the path of spec3.sdk.json, or when it is in the config folder", OpenAPISpecEnv))
 
 	// Conditionally add flags for GET requests. I'm doing it here to keep `limit`, `start_after` and `ending_before` unexported
 	if rb.Method == http.MethodGet {
@@ -168,6 +202,35 @@ func (rb *Base) InitFlags() {
 		if rb.Cmd.Flags().Lookup("ending-before") == nil {
 			rb.Cmd.Flags().StringVarP(&rb.Parameters.endingBefore, "ending-before", "b", "", "Retrieve the previous page in the list. This is a cursor for pagination and should be an object ID")
 		}
+
+		if rb.Cmd.Flags().Lookup("all") == nil {
+			rb.Cmd.Flags().BoolVar(&rb.pagination.all, "all", false, "Retrieve every object of the list by following its pages")
+		}
+
+		if rb.Cmd.Flags().Lookup("max-items") == nil {
+			rb.Cmd.Flags().IntVar(&rb.pagination.maxItems, "max-items", 0, "Retrieve at most this many objects of the list by following its pages")
+		}
+	}
+
+	// Bulk requests are only supported for requests that change objects
+	if rb.Method != http.MethodGet {
+		rb.Cmd.Flags().StringVar(&rb.bulk.fromFile, "from-file", "", "Make a request for every row of a CSV or NDJSON file, using its columns as params. Every row is sent with an idempotency key derived from the file and the row, or <key>-N for row N with --idempotency")
+		rb.Cmd.Flags().IntVar(&rb.bulk.concurrency, "concurrency", defaultBulkConcurrency, "Number of concurrent requests with --from-file")
+		rb.Cmd.Flags().StringVar(&rb.bulk.resultsFile, "results-file", "", "File to write the result of every row to with --from-file (default is <file>.results.ndjson)")
+		rb.Cmd.Flags().BoolVar(&rb.bulk.resume, "resume", false, "Skip the rows that already succeeded according to the results file with --from-file")
+	}
+
+	if rb.Cmd.Flags().Lookup("format") == nil {
+		rb.Cmd.Flags().StringVar(&rb.output.format, "format", FormatJSON, "Output format of the response (json, yaml, table, csv, ndjson)")
+	}
+
+	if rb.Cmd.Flags().Lookup("fields") == nil {
+		rb.Cmd.Flags().StringSliceVar(&rb.output.fields, "fields", []string{}, "Comma-separated response fields to output, as gjson paths. For lists, the fields of each object of the list")
+	}
+
+	// Not named `query`, which is a param of search operations
+	if rb.Cmd.Flags().Lookup("select") == nil {
+		rb.Cmd.Flags().StringVar(&rb.output.selection, "select", "", "gjson path to select part of the response, e.g. data.#.email, or of each object with --all and --max-items")
 	}
 
 	// Hidden configuration flags, useful for dev/debugging
@@ -207,10 +270,17 @@ func (rb *Base) performRequest(ctx context.Context, apiKey, path string, params
 		return []byte{}, err
 	}
 
+	if !rb.SuppressOutput {
+		if err := rb.output.validate(); err != nil {
+			return []byte{}, err
+		}
+	}
+
 	client := &stripe.Client{
-		BaseURL: parsedBaseURL,
-		APIKey:  apiKey,
-		Verbose: rb.showHeaders,
+		BaseURL:    parsedBaseURL,
+		APIKey:     apiKey,
+		Verbose:    rb.showHeaders,
+		MaxRetries: stripe.DefaultMaxRetries,
 	}
 
 	configure := func(req *http.Request) {
@@ -229,6 +299,10 @@ func (rb *Base) performRequest(ctx context.Context, apiKey, path string, params
 	}
 	defer resp.Body.Close()
 
+	if rb.OnResponse != nil {
+		rb.OnResponse(resp)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 
 	if resp.StatusCode == 401 || (errOnStatus && resp.StatusCode >= 300) {
@@ -236,13 +310,22 @@ func (rb *Base) performRequest(ctx context.Context, apiKey, path string, params
 		return []byte{}, requestError
 	}
 
+	if err == nil && resp.StatusCode < 300 {
+		rb.recordRequest(path, params, body)
+		rb.recordObjectIDs(body)
+	}
+
 	if !rb.SuppressOutput {
 		if err != nil {
 			return []byte{}, err
 		}
 
-		result := ansi.ColorizeJSON(string(body), rb.DarkStyle, os.Stdout)
-		fmt.Print(result)
+		// Errors are printed as they were received
+		if resp.StatusCode >= 300 {
+			fmt.Print(ansi.ColorizeJSON(string(body), rb.DarkStyle, os.Stdout))
+		} else if err := rb.output.write(os.Stdout, body, rb.DarkStyle); err != nil {
+			return []byte{}, err
+		}
 	}
 
 	return body, nil
@@ -3,14 +3,17 @@ package requests
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
 
@@ -297,3 +300,56 @@ func TestIsAPIKeyExpiredError(t *testing.T) {
 		require.False(t, IsAPIKeyExpiredError(fmt.Errorf("other")))
 	})
 }
+
+func TestMakeRequestRecordsSession(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "cus_123", "object": "customer"}`))
+	}))
+	defer ts.Close()
+
+	logFile := filepath.Join(t.TempDir(), "session.log")
+	t.Setenv(SessionLogEnv, logFile)
+
+	params := &RequestParameters{data: []string{"email=jenny.rosen@example.com"}}
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodPost, Cmd: &cobra.Command{}}
+	_, err := rb.MakeRequest(context.Background(), "sk_test_1234", "/v1/customers", params, true)
+	require.NoError(t, err)
+
+	// Requests of commands are recorded even when their output is
+	// suppressed, as for the pages of --all
+	rb.SuppressOutput = true
+	_, err = rb.MakeRequest(context.Background(), "sk_test_1234", "/v1/customers", params, true)
+	require.NoError(t, err)
+
+	// Requests made on behalf of other commands are not recorded
+	other := Base{APIBaseURL: ts.URL, Method: http.MethodPost, SuppressOutput: true}
+	_, err = other.MakeRequest(context.Background(), "sk_test_1234", "/v1/customers", params, true)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var entry RecordedRequest
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, http.MethodPost, entry.Method)
+	require.Equal(t, "/v1/customers", entry.Path)
+	require.Equal(t, []string{"email=jenny.rosen@example.com"}, entry.Params)
+	require.Equal(t, "cus_123", entry.ResponseID)
+	require.Equal(t, "customer", entry.Object)
+}
+
+func TestInitFlagsSearchQuery(t *testing.T) {
+	// Search operations define a `query` param before the request flags
+	cmd := &cobra.Command{}
+	cmd.Flags().String("query", "", "The search query")
+
+	rb := Base{Cmd: cmd}
+	rb.InitFlags()
+
+	require.NoError(t, cmd.Flags().Parse([]string{"--query", "email:'jenny@example.com'", "--select", "data.#.id"}))
+	require.Equal(t, "data.#.id", rb.output.selection)
+}
@@ -0,0 +1,484 @@
+package requests
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/briandowns/spinner"
+	"golang.org/x/term"
+
+	"github.com/stripe/stripe-cli/pkg/ansi"
+)
+
+// Bulk requests make one request per row of a CSV or NDJSON file. CSV
+// columns and NDJSON keys are param names, e.g. `email` or
+// `metadata[plan]`. Empty CSV cells are left out, while null NDJSON values
+// are sent empty, which unsets them. Placeholders in the path such as
+// `/v1/customers/{customer}` are filled in from the column of the same
+// name, or from the `id` column for paths with a single placeholder.
+//
+// The result of every row is appended to a results file as NDJSON. When a
+// bulk run is resumed, the rows that already succeeded according to the
+// results file are skipped, and the other rows are sent with the same
+// idempotency keys as before.
+
+const defaultBulkConcurrency = 4
+
+// Statuses of the rows in the results file
+const (
+	BulkRowSucceeded = "succeeded"
+	BulkRowFailed    = "failed"
+)
+
+var pathParamRegex = regexp.MustCompile(`{(\w+)}`)
+
+// bulk holds the flags of bulk requests
+type bulk struct {
+	fromFile    string
+	concurrency int
+	resultsFile string
+	resume      bool
+}
+
+// BulkRowResult is the result of a row of a bulk request
+type BulkRowResult struct {
+	Row    int    `json:"row"`
+	Status string `json:"status"`
+	Path   string `json:"path,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type bulkRow struct {
+	number int
+	params map[string]string
+	keys   []string
+}
+
+// Bulk reports whether the request should be made for every row of a file,
+// i.e. whether --from-file was set
+func (rb *Base) Bulk() bool {
+	return rb.bulk.fromFile != ""
+}
+
+// MakeBulkRequests makes a request for every row of the --from-file file.
+// The path can contain `{name}` placeholders, and the params are added to
+// every request.
+func (rb *Base) MakeBulkRequests(ctx context.Context, apiKey, pathTemplate string, params *RequestParameters) error {
+	rows, err := readBulkRows(rb.bulk.fromFile)
+	if err != nil {
+		return err
+	}
+
+	resultsFile := rb.bulk.resultsFile
+	if resultsFile == "" {
+		resultsFile = strings.TrimSuffix(rb.bulk.fromFile, filepath.Ext(rb.bulk.fromFile)) + ".results.ndjson"
+	}
+
+	done := make(map[int]bool)
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if rb.bulk.resume {
+		done, err = succeededBulkRows(resultsFile)
+		if err != nil {
+			return err
+		}
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	results, err := os.OpenFile(resultsFile, flags, 0600)
+	if err != nil {
+		return err
+	}
+	defer results.Close()
+
+	summary, err := rb.runBulkRows(ctx, apiKey, pathTemplate, params, rows, done, results, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Processed %d rows: %d succeeded, %d failed, %d skipped. Results were written to %s\n",
+		len(rows), summary.succeeded, summary.failed, summary.skipped, resultsFile)
+
+	if summary.failed > 0 {
+		return fmt.Errorf("%d rows failed. Run the command again with --resume to retry them", summary.failed)
+	}
+
+	return nil
+}
+
+type bulkSummary struct {
+	succeeded int
+	failed    int
+	skipped   int
+}
+
+// runBulkRows makes the requests of the rows that are not done, writes
+// their results and reports the progress to progressWriter
+func (rb *Base) runBulkRows(ctx context.Context, apiKey, pathTemplate string, params *RequestParameters, rows []bulkRow, done map[int]bool, results io.Writer, progressWriter io.Writer) (bulkSummary, error) {
+	var summary bulkSummary
+	var mu sync.Mutex
+	var writeErr error
+
+	concurrency := rb.bulk.concurrency
+	if concurrency < 1 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	progress := newBulkProgress(len(rows), progressWriter)
+
+	record := func(result BulkRowResult) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if result.Status == BulkRowSucceeded {
+			summary.succeeded++
+		} else {
+			summary.failed++
+		}
+		progress.update(summary)
+
+		line, err := json.Marshal(result)
+		if err == nil {
+			_, err = results.Write(append(line, '\n'))
+		}
+		if err != nil && writeErr == nil {
+			writeErr = err
+		}
+	}
+
+	jobs := make(chan bulkRow)
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range jobs {
+				record(rb.makeBulkRowRequest(ctx, apiKey, pathTemplate, params, row))
+			}
+		}()
+	}
+
+	for _, row := range rows {
+		if done[row.number] {
+			mu.Lock()
+			summary.skipped++
+			mu.Unlock()
+			continue
+		}
+		if ctx != nil && ctx.Err() != nil {
+			break
+		}
+		jobs <- row
+	}
+	close(jobs)
+	wg.Wait()
+
+	progress.stop(summary)
+
+	return summary, writeErr
+}
+
+func (rb *Base) makeBulkRowRequest(ctx context.Context, apiKey, pathTemplate string, params *RequestParameters, row bulkRow) BulkRowResult {
+	result := BulkRowResult{Row: row.number, Status: BulkRowFailed}
+
+	path, used, err := fillPathParams(pathTemplate, row)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Path = path
+
+	rowParams := *params
+	rowParams.data = append([]string{}, params.data...)
+	for _, key := range row.keys {
+		if !used[key] {
+			rowParams.data = append(rowParams.data, fmt.Sprintf("%s=%s", key, row.params[key]))
+		}
+	}
+
+	// Every row is a different request, so it needs its own idempotency key
+	rowParams.idempotency = rb.bulkRowIdempotencyKey(params.idempotency, path, &rowParams, row)
+
+	if err := rb.ValidateParams(path, &rowParams); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	req := *rb
+	req.SuppressOutput = true
+
+	body, err := req.MakeRequest(ctx, apiKey, path, &rowParams, true)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = BulkRowSucceeded
+
+	var object struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(body, &object) == nil {
+		result.ID = object.ID
+	}
+
+	return result
+}
+
+// bulkRowIdempotencyKey returns the idempotency key of a row: <key>-N for
+// row N with --idempotency, and otherwise a key derived from the file, the
+// row and its request. The key is the same when a run is resumed, so that
+// the rows whose requests timed out are not created twice.
+func (rb *Base) bulkRowIdempotencyKey(key, path string, rowParams *RequestParameters, row bulkRow) string {
+	if key != "" {
+		return fmt.Sprintf("%s-%d", key, row.number)
+	}
+
+	// Only POST requests take idempotency keys
+	if rb.Method != http.MethodPost {
+		return ""
+	}
+
+	file, err := filepath.Abs(rb.bulk.fromFile)
+	if err != nil {
+		file = rb.bulk.fromFile
+	}
+
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\n%d\n%s %s\n", file, row.number, rb.Method, path)
+	for _, datum := range rowParams.data {
+		fmt.Fprintf(hash, "%s\n", datum)
+	}
+
+	return fmt.Sprintf("bulk-%x", hash.Sum(nil)[:16])
+}
+
+// fillPathParams replaces the placeholders of the path with the values of
+// the row, and returns the keys of the row that were used
+func fillPathParams(pathTemplate string, row bulkRow) (string, map[string]bool, error) {
+	used := make(map[string]bool)
+	placeholders := pathParamRegex.FindAllStringSubmatch(pathTemplate, -1)
+
+	var missing string
+	path := pathParamRegex.ReplaceAllStringFunc(pathTemplate, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+
+		key := name
+		if _, ok := row.params[key]; !ok && len(placeholders) == 1 {
+			key = "id"
+		}
+
+		value, ok := row.params[key]
+		if !ok || value == "" {
+			missing = name
+			return placeholder
+		}
+
+		used[key] = true
+		return value
+	})
+
+	if missing != "" {
+		return "", nil, fmt.Errorf("row %d has no value for %s", row.number, missing)
+	}
+
+	return path, used, nil
+}
+
+// readBulkRows reads the rows of a CSV file, or of an NDJSON file when its
+// extension is .ndjson or .jsonl
+func readBulkRows(file string) ([]bulkRow, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".ndjson", ".jsonl":
+		return readNDJSONRows(f)
+	default:
+		return readCSVRows(f)
+	}
+}
+
+func readCSVRows(r io.Reader) ([]bulkRow, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("the CSV file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var rows []bulkRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row := bulkRow{number: len(rows) + 1, params: make(map[string]string)}
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+			row.keys = append(row.keys, header[i])
+			row.params[header[i]] = value
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func readNDJSONRows(r io.Reader) ([]bulkRow, error) {
+	var rows []bulkRow
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(text), &object); err != nil {
+			return nil, fmt.Errorf("invalid JSON on line %d: %w", line, err)
+		}
+
+		row := bulkRow{number: len(rows) + 1, params: make(map[string]string)}
+		flattenParams("", object, &row)
+		rows = append(rows, row)
+	}
+
+	return rows, scanner.Err()
+}
+
+// flattenParams adds the values of a JSON object as form encoded params,
+// e.g. {"metadata": {"plan": "pro"}} as `metadata[plan]`
+func flattenParams(prefix string, value interface{}, row *bulkRow) {
+	add := func(value string) {
+		row.keys = append(row.keys, prefix)
+		row.params[prefix] = value
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			name := key
+			if prefix != "" {
+				name = fmt.Sprintf("%s[%s]", prefix, key)
+			}
+			flattenParams(name, v[key], row)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenParams(fmt.Sprintf("%s[%d]", prefix, i), item, row)
+		}
+	case string:
+		add(v)
+	case float64:
+		add(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		add(strconv.FormatBool(v))
+	case nil:
+		add("")
+	}
+}
+
+// succeededBulkRows returns the rows that succeeded according to a results
+// file. A missing results file has no rows.
+func succeededBulkRows(file string) (map[int]bool, error) {
+	done := make(map[int]bool)
+
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var result BulkRowResult
+		if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+			continue
+		}
+		if result.Status == BulkRowSucceeded {
+			done[result.Row] = true
+		}
+	}
+
+	return done, scanner.Err()
+}
+
+// bulkProgress shows the progress of bulk requests in a spinner. Nothing
+// is shown until the end when the output is not a terminal.
+type bulkProgress struct {
+	total   int
+	w       io.Writer
+	spinner *spinner.Spinner
+}
+
+func newBulkProgress(total int, w io.Writer) *bulkProgress {
+	p := &bulkProgress{total: total, w: w}
+	if f, ok := w.(*os.File); ok && term.IsTerminal(int(f.Fd())) {
+		p.spinner = ansi.StartNewSpinner(p.message(bulkSummary{}), w)
+	}
+
+	return p
+}
+
+func (p *bulkProgress) message(summary bulkSummary) string {
+	return fmt.Sprintf("Processed %d/%d rows (%d failed)", summary.succeeded+summary.failed, p.total, summary.failed)
+}
+
+func (p *bulkProgress) update(summary bulkSummary) {
+	if p.spinner == nil {
+		return
+	}
+
+	p.spinner.Lock()
+	p.spinner.Suffix = " " + p.message(summary)
+	p.spinner.Unlock()
+}
+
+func (p *bulkProgress) stop(summary bulkSummary) {
+	if p.spinner == nil {
+		return
+	}
+
+	ansi.StopSpinner(p.spinner, p.message(summary), p.w)
+}
@@ -0,0 +1,222 @@
+package requests
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func readBulkResults(t *testing.T, file string) []BulkRowResult {
+	f, err := os.Open(file)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var results []BulkRowResult
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var result BulkRowResult
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &result))
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Row < results[j].Row })
+
+	return results
+}
+
+func TestMakeBulkRequestsCSV(t *testing.T) {
+	var mu sync.Mutex
+	bodies := make(map[string]url.Values)
+	failed := true
+
+	idempotencyKeys := make(map[string]string)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		values, err := url.ParseQuery(string(body))
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		bodies[r.URL.Path] = values
+		idempotencyKeys[r.URL.Path] = r.Header.Get("Idempotency-Key")
+		if r.URL.Path == "/v1/customers/cus_3" && failed {
+			w.Header().Set("Stripe-Should-Retry", "false")
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"type": "invalid_request_error"}}`))
+			return
+		}
+		w.Write([]byte(`{"id": "` + strings.TrimPrefix(r.URL.Path, "/v1/customers/") + `"}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "customers.csv")
+	require.NoError(t, os.WriteFile(input, []byte("id,email,metadata[plan]\ncus_1,fry@example.com,pro\ncus_2,,basic\ncus_3,leela@example.com,pro\n"), 0600))
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodPost}
+	rb.bulk = bulk{fromFile: input, concurrency: 2}
+	params := &RequestParameters{data: []string{"description=backfill"}, idempotency: "backfill"}
+
+	err := rb.MakeBulkRequests(context.Background(), "sk_test_1234", "/v1/customers/{customer}", params)
+	require.EqualError(t, err, "1 rows failed. Run the command again with --resume to retry them")
+	require.Equal(t, "backfill-1", idempotencyKeys["/v1/customers/cus_1"])
+	require.Equal(t, "backfill-2", idempotencyKeys["/v1/customers/cus_2"])
+
+	require.Equal(t, url.Values{"email": {"fry@example.com"}, "metadata[plan]": {"pro"}, "description": {"backfill"}}, bodies["/v1/customers/cus_1"])
+	require.Equal(t, url.Values{"metadata[plan]": {"basic"}, "description": {"backfill"}}, bodies["/v1/customers/cus_2"])
+
+	resultsFile := filepath.Join(dir, "customers.results.ndjson")
+	results := readBulkResults(t, resultsFile)
+	require.Len(t, results, 3)
+	require.Equal(t, BulkRowResult{Row: 1, Status: BulkRowSucceeded, Path: "/v1/customers/cus_1", ID: "cus_1"}, results[0])
+	require.Equal(t, BulkRowFailed, results[2].Status)
+	require.Contains(t, results[2].Error, "status=400")
+
+	// Resuming only retries the failed row
+	failed = false
+	bodies = make(map[string]url.Values)
+	rb.bulk.resume = true
+
+	err = rb.MakeBulkRequests(context.Background(), "sk_test_1234", "/v1/customers/{customer}", params)
+	require.NoError(t, err)
+	require.Len(t, bodies, 1)
+	require.Contains(t, bodies, "/v1/customers/cus_3")
+
+	results = readBulkResults(t, resultsFile)
+	require.Len(t, results, 4)
+	require.Equal(t, BulkRowSucceeded, results[3].Status)
+}
+
+func TestBulkRowIdempotencyKey(t *testing.T) {
+	dir := t.TempDir()
+	rb := Base{Method: http.MethodPost}
+	rb.bulk = bulk{fromFile: filepath.Join(dir, "customers.csv")}
+
+	row := bulkRow{number: 1}
+	params := &RequestParameters{data: []string{"email=fry@example.com"}}
+
+	// Rows are sent with the same key when a run is resumed
+	key := rb.bulkRowIdempotencyKey("", "/v1/customers", params, row)
+	require.Regexp(t, `^bulk-[0-9a-f]{32}$`, key)
+	require.Equal(t, key, rb.bulkRowIdempotencyKey("", "/v1/customers", params, row))
+
+	// and with another key for another row, content or file
+	require.NotEqual(t, key, rb.bulkRowIdempotencyKey("", "/v1/customers", params, bulkRow{number: 2}))
+	require.NotEqual(t, key, rb.bulkRowIdempotencyKey("", "/v1/customers", &RequestParameters{data: []string{"email=leela@example.com"}}, row))
+	other := rb
+	other.bulk.fromFile = filepath.Join(dir, "other.csv")
+	require.NotEqual(t, key, other.bulkRowIdempotencyKey("", "/v1/customers", params, row))
+
+	require.Equal(t, "backfill-1", rb.bulkRowIdempotencyKey("backfill", "/v1/customers", params, row))
+
+	rb.Method = http.MethodDelete
+	require.Empty(t, rb.bulkRowIdempotencyKey("", "/v1/customers/cus_1", params, row))
+}
+
+func TestReadNDJSONRows(t *testing.T) {
+	rows, err := readNDJSONRows(strings.NewReader(`{"email": "fry@example.com", "metadata": {"plan": "pro", "seats": 3}, "tax_exempt": null}
+
+{"preferred_locales": ["en", "fr"], "invoice_settings": {"custom_fields": [{"name": "PO", "value": "42"}]}}
+`))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	require.Equal(t, 1, rows[0].number)
+	require.Equal(t, []string{"email", "metadata[plan]", "metadata[seats]", "tax_exempt"}, rows[0].keys)
+	require.Equal(t, "3", rows[0].params["metadata[seats]"])
+	require.Equal(t, "", rows[0].params["tax_exempt"])
+
+	require.Equal(t, 2, rows[1].number)
+	require.Equal(t, map[string]string{
+		"preferred_locales[0]":                      "en",
+		"preferred_locales[1]":                      "fr",
+		"invoice_settings[custom_fields][0][name]":  "PO",
+		"invoice_settings[custom_fields][0][value]": "42",
+	}, rows[1].params)
+}
+
+func TestFillPathParams(t *testing.T) {
+	row := bulkRow{number: 4, params: map[string]string{"customer": "cus_1", "id": "txn_1"}}
+
+	path, used, err := fillPathParams("/v1/customers/{customer}/balance_transactions/{transaction}", row)
+	require.EqualError(t, err, "row 4 has no value for transaction")
+	require.Empty(t, path)
+	require.Nil(t, used)
+
+	path, used, err = fillPathParams("/v1/customers/{customer}", row)
+	require.NoError(t, err)
+	require.Equal(t, "/v1/customers/cus_1", path)
+	require.Equal(t, map[string]bool{"customer": true}, used)
+
+	path, _, err = fillPathParams("/v1/balance_transactions/{transaction}", row)
+	require.NoError(t, err)
+	require.Equal(t, "/v1/balance_transactions/txn_1", path)
+}
+
+func TestMakeBulkRequestsValidatesRows(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "spec3.sdk.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{
+  "paths": {
+    "/v1/customers/{customer}": {
+      "post": {
+        "requestBody": {
+          "content": {
+            "application/x-www-form-urlencoded": {
+              "schema": {"type": "object", "properties": {"email": {"type": "string"}}}
+            }
+          }
+        }
+      }
+    }
+  }
+}`), 0600))
+	t.Setenv(OpenAPISpecEnv, file)
+
+	loadSpecOnce = sync.Once{}
+	defer func() {
+		loadSpecOnce = sync.Once{}
+		loadedSpec = nil
+	}()
+
+	var mu sync.Mutex
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"id": "cus_1"}`))
+	}))
+	defer ts.Close()
+
+	dir := t.TempDir()
+	input := filepath.Join(dir, "customers.csv")
+	require.NoError(t, os.WriteFile(input, []byte("customer,email,emial\ncus_1,fry@example.com,\ncus_2,,leela@example.com\n"), 0600))
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodPost}
+	rb.bulk = bulk{fromFile: input, concurrency: 1}
+
+	err := rb.MakeBulkRequests(context.Background(), "sk_test_1234", "/v1/customers/{customer}", &RequestParameters{})
+	require.EqualError(t, err, "1 rows failed. Run the command again with --resume to retry them")
+
+	// The invalid row isn't sent
+	require.Equal(t, []string{"/v1/customers/cus_1"}, paths)
+
+	results := readBulkResults(t, filepath.Join(dir, "customers.results.ndjson"))
+	require.Len(t, results, 2)
+	require.Contains(t, results[1].Error, "unknown parameter emial")
+}
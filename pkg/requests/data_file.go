@@ -0,0 +1,130 @@
+package requests
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// stdinData is the value of --data that reads the params from stdin
+const stdinData = "-"
+
+// LoadData replaces `--data -` with the params read from stdin and adds the
+// params of the --data-file file. The params are JSON or YAML objects,
+// which are converted to form encoded params such as `metadata[plan]=pro`.
+func (rb *Base) LoadData(params *RequestParameters) error {
+	return loadData(params, os.Stdin)
+}
+
+func loadData(params *RequestParameters, stdin io.Reader) error {
+	var data []string
+
+	if params.dataFile != "" {
+		content, err := os.ReadFile(params.dataFile)
+		if err != nil {
+			return err
+		}
+
+		parsed, err := parseDataParams(content, params.dataFile)
+		if err != nil {
+			return err
+		}
+		data = append(data, parsed...)
+	}
+
+	readStdin := false
+	for _, datum := range params.data {
+		if datum != stdinData {
+			data = append(data, datum)
+			continue
+		}
+
+		if readStdin {
+			return fmt.Errorf("--data - can only be used once")
+		}
+		readStdin = true
+
+		content, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+
+		parsed, err := parseDataParams(content, "stdin")
+		if err != nil {
+			return err
+		}
+		data = append(data, parsed...)
+	}
+
+	params.data = data
+	params.dataFile = ""
+
+	return nil
+}
+
+// parseDataParams converts a JSON or YAML object to form encoded params.
+// The params keep the order of the keys of the object, since some
+// endpoints expect params in a given order.
+func parseDataParams(content []byte, source string) ([]string, error) {
+	// Tabs can only be whitespace in valid JSON, but YAML doesn't allow them
+	// for indentation
+	if trimmed := bytes.TrimSpace(content); len(trimmed) > 0 && trimmed[0] == '{' {
+		content = bytes.ReplaceAll(content, []byte("\t"), []byte(" "))
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON or YAML in %s: %w", source, err)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("the params in %s must be an object", source)
+	}
+
+	params, err := decodeParams(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON or YAML in %s: %w", source, err)
+	}
+
+	return FormEncoder{}.Encode(params)
+}
+
+// decodeParams decodes a node, keeping the order of the keys of objects
+func decodeParams(node *yaml.Node) (interface{}, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return decodeParams(node.Alias)
+	case yaml.MappingNode:
+		params := make(orderedParams, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := decodeParams(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, orderedParam{key: node.Content[i].Value, value: value})
+		}
+		return params, nil
+	case yaml.SequenceNode:
+		items := make([]interface{}, 0, len(node.Content))
+		for _, item := range node.Content {
+			value, err := decodeParams(item)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		}
+		return items, nil
+	default:
+		var value interface{}
+		err := node.Decode(&value)
+		return value, err
+	}
+}
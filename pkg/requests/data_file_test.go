@@ -0,0 +1,86 @@
+package requests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDataParamsJSON(t *testing.T) {
+	content := `{
+	"mode": "payment",
+	"line_items": [{"price": "price_123", "quantity": 2}],
+	"payment_method_types": ["card", "sepa_debit"],
+	"metadata": {"order": "6735", "note": null},
+	"amount": 20.5,
+	"capture": true
+}`
+
+	params, err := parseDataParams([]byte(content), "session.json")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"mode=payment",
+		"line_items[0][price]=price_123",
+		"line_items[0][quantity]=2",
+		"payment_method_types[]=card",
+		"payment_method_types[]=sepa_debit",
+		"metadata[order]=6735",
+		"metadata[note]=",
+		"amount=20.5",
+		"capture=true",
+	}, params)
+}
+
+func TestParseDataParamsYAML(t *testing.T) {
+	content := `
+email: jenny@example.com
+shipping:
+  name: Jenny Rosen
+  address:
+    line1: 510 Townsend St
+`
+
+	params, err := parseDataParams([]byte(content), "customer.yaml")
+
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"email=jenny@example.com",
+		"shipping[name]=Jenny Rosen",
+		"shipping[address][line1]=510 Townsend St",
+	}, params)
+}
+
+func TestParseDataParamsNotAnObject(t *testing.T) {
+	_, err := parseDataParams([]byte(`["card"]`), "stdin")
+	require.EqualError(t, err, "the params in stdin must be an object")
+
+	_, err = parseDataParams([]byte(`{"email": `), "stdin")
+	require.Error(t, err)
+}
+
+func TestLoadData(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "params.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"currency": "usd"}`), 0600))
+
+	params := RequestParameters{
+		data:     []string{"-", "amount=2000"},
+		dataFile: file,
+	}
+
+	err := loadData(&params, strings.NewReader(`{"metadata": {"order": "6735"}}`))
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"currency=usd", "metadata[order]=6735", "amount=2000"}, params.data)
+}
+
+func TestLoadDataStdinOnce(t *testing.T) {
+	params := RequestParameters{data: []string{"-", "-"}}
+
+	err := loadData(&params, strings.NewReader(`{}`))
+
+	require.EqualError(t, err, "--data - can only be used once")
+}
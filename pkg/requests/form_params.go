@@ -0,0 +1,135 @@
+package requests
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// FormEncoder converts params decoded from JSON or YAML, such as the params
+// of fixtures and of --data-file, to form encoded params: objects are
+// nested with brackets (`shipping[name]`), lists of values with empty
+// brackets (`expand[]`) and lists of objects with their index
+// (`items[0][price]`). The items of nested lists are sent as items of
+// their parent list.
+type FormEncoder struct {
+	// Resolve, when set, is applied to string values, e.g. to replace the
+	// references of fixtures with the values they point to
+	Resolve func(string) (string, error)
+	// SkipNulls leaves out null values, which are otherwise sent empty to
+	// unset them
+	SkipNulls bool
+}
+
+// Encode returns the form encoded params of params
+func (e FormEncoder) Encode(params interface{}) ([]string, error) {
+	return e.encode(params, "")
+}
+
+// orderedParams are the params of an object whose keys must be sent in
+// order, since some endpoints expect params in a given order
+type orderedParams []orderedParam
+
+type orderedParam struct {
+	key   string
+	value interface{}
+}
+
+func (e FormEncoder) encode(value interface{}, name string) ([]string, error) {
+	var params []string
+
+	switch v := value.(type) {
+	case nil:
+		if e.SkipNulls {
+			return nil, nil
+		}
+		return []string{name + "="}, nil
+	case orderedParams:
+		for _, param := range v {
+			nested, err := e.encode(param.value, nestedName(name, param.key))
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, nested...)
+		}
+		return params, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			nested, err := e.encode(v[key], nestedName(name, key))
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, nested...)
+		}
+		return params, nil
+	case []interface{}:
+		return e.encodeList(v, name)
+	case string:
+		if e.Resolve != nil {
+			resolved, err := e.Resolve(v)
+			if err != nil {
+				return nil, err
+			}
+			v = resolved
+		}
+		return []string{fmt.Sprintf("%s=%s", name, v)}, nil
+	}
+
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Bool:
+		return []string{fmt.Sprintf("%s=%t", name, v.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{fmt.Sprintf("%s=%d", name, v.Int())}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []string{fmt.Sprintf("%s=%d", name, v.Uint())}, nil
+	case reflect.Float32, reflect.Float64:
+		// JSON numbers are decoded as floats: 10 is sent as 10, 25.00 as
+		// 25 and 20.10 as 20.1, without scientific notation
+		return []string{fmt.Sprintf("%s=%s", name, strconv.FormatFloat(v.Float(), 'f', -1, 64))}, nil
+	}
+
+	// Values that can't be sent as params are skipped
+	return nil, nil
+}
+
+func (e FormEncoder) encodeList(items []interface{}, name string) ([]string, error) {
+	var params []string
+
+	// Only the objects of the list are indexed, e.g. lines[0][price]
+	index := 0
+	for _, item := range items {
+		var nested []string
+		var err error
+
+		switch item.(type) {
+		case orderedParams, map[string]interface{}:
+			nested, err = e.encode(item, fmt.Sprintf("%s[%d]", name, index))
+			index++
+		case []interface{}:
+			nested, err = e.encode(item, name)
+		default:
+			nested, err = e.encode(item, name+"[]")
+		}
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, nested...)
+	}
+
+	return params, nil
+}
+
+func nestedName(name, key string) string {
+	if name == "" {
+		return key
+	}
+
+	return fmt.Sprintf("%s[%s]", name, key)
+}
@@ -0,0 +1,46 @@
+package requests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormEncoder(t *testing.T) {
+	params := map[string]interface{}{
+		"amount":  float64(2000),
+		"capture": false,
+		"expand":  []interface{}{"customer", "invoice"},
+		"items": []interface{}{
+			map[string]interface{}{"price_data": map[string]interface{}{"currency": "usd"}},
+			map[string]interface{}{"price": "${price:id}"},
+		},
+		"description": nil,
+		"tiers":       []interface{}{[]interface{}{"a", "b"}, []interface{}{map[string]interface{}{"up_to": "inf"}}},
+	}
+
+	resolve := func(value string) (string, error) {
+		return strings.ReplaceAll(value, "${price:id}", "price_123"), nil
+	}
+
+	data, err := FormEncoder{Resolve: resolve}.Encode(params)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"amount=2000",
+		"capture=false",
+		"description=",
+		"expand[]=customer",
+		"expand[]=invoice",
+		"items[0][price_data][currency]=usd",
+		"items[1][price]=price_123",
+		"tiers[]=a",
+		"tiers[]=b",
+		"tiers[0][up_to]=inf",
+	}, data)
+
+	// Null values can be left out rather than unset
+	data, err = FormEncoder{SkipNulls: true}.Encode(map[string]interface{}{"description": nil, "name": "Jenny"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"name=Jenny"}, data)
+}
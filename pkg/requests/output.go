@@ -0,0 +1,257 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/pretty"
+	"gopkg.in/yaml.v3"
+
+	"github.com/stripe/stripe-cli/pkg/ansi"
+)
+
+// Output formats of API responses
+const (
+	FormatJSON   = "json"
+	FormatYAML   = "yaml"
+	FormatTable  = "table"
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+var outputFormats = []string{FormatJSON, FormatYAML, FormatTable, FormatCSV, FormatNDJSON}
+
+// output holds the flags that control how responses are printed.
+//
+// The selection is a gjson path (https://github.com/tidwall/gjson/blob/master/SYNTAX.md)
+// applied to the response first. The fields are gjson paths projected from
+// every object of the result: for lists, the objects of their `data` array.
+// Table and CSV output have a column per field, or per top-level scalar
+// attribute of the first object when no fields are given.
+type output struct {
+	format    string
+	fields    []string
+	selection string
+}
+
+func (o output) validate() error {
+	for _, format := range outputFormats {
+		if o.format == "" || o.format == format {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported format: %s. Supported formats are: %s", o.format, strings.Join(outputFormats, ", "))
+}
+
+// isRaw reports whether responses are printed as they were received
+func (o output) isRaw() bool {
+	return (o.format == "" || o.format == FormatJSON) && len(o.fields) == 0 && o.selection == ""
+}
+
+// write prints a response body
+func (o output) write(w io.Writer, body []byte, darkStyle bool) error {
+	if o.isRaw() {
+		fmt.Fprint(w, ansi.ColorizeJSON(string(body), darkStyle, w))
+		return nil
+	}
+
+	result := gjson.ParseBytes(body)
+	if o.selection != "" {
+		result = result.Get(o.selection)
+	}
+
+	switch {
+	case len(o.fields) == 0 && (o.format == "" || o.format == FormatJSON || o.format == FormatYAML):
+		// Without fields, JSON and YAML keep the result as it is
+		return o.writeObjects(w, []gjson.Result{result}, false, darkStyle)
+	case isListObject(result):
+		return o.writeObjects(w, result.Get("data").Array(), true, darkStyle)
+	case result.IsArray():
+		return o.writeObjects(w, result.Array(), true, darkStyle)
+	default:
+		return o.writeObjects(w, []gjson.Result{result}, false, darkStyle)
+	}
+}
+
+// project returns the fields of an object as JSON, or the whole object
+// when no fields are given
+func (o output) project(object gjson.Result) []byte {
+	if len(o.fields) == 0 {
+		return []byte(rawOrNull(object))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o.fields {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(field)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.WriteString(rawOrNull(object.Get(field)))
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes()
+}
+
+// writeObjects prints objects in the output format. isList tells whether
+// the objects are printed as a list or as a single object in the JSON and
+// YAML formats.
+func (o output) writeObjects(w io.Writer, objects []gjson.Result, isList bool, darkStyle bool) error {
+	switch o.format {
+	case "", FormatJSON, FormatYAML:
+		var value []byte
+		if isList {
+			value = []byte("[")
+			for i, object := range objects {
+				if i > 0 {
+					value = append(value, ',')
+				}
+				value = append(value, o.project(object)...)
+			}
+			value = append(value, ']')
+		} else if len(objects) > 0 {
+			value = o.project(objects[0])
+		}
+
+		if o.format == FormatYAML {
+			return writeYAML(w, value)
+		}
+		fmt.Fprint(w, ansi.ColorizeJSON(string(pretty.Pretty(value)), darkStyle, w))
+		return nil
+	case FormatNDJSON:
+		for _, object := range objects {
+			fmt.Fprintln(w, string(pretty.Ugly(o.project(object))))
+		}
+		return nil
+	case FormatTable, FormatCSV:
+		columns := o.fields
+		if len(columns) == 0 && len(objects) > 0 {
+			columns = scalarKeys(objects[0])
+		}
+
+		rows := make([][]string, 0, len(objects))
+		for _, object := range objects {
+			row := make([]string, len(columns))
+			for i, column := range columns {
+				row[i] = cellValue(object.Get(column))
+			}
+			rows = append(rows, row)
+		}
+
+		if o.format == FormatCSV {
+			return writeCSV(w, columns, rows)
+		}
+		return writeTable(w, columns, rows)
+	default:
+		return o.validate()
+	}
+}
+
+func writeYAML(w io.Writer, value []byte) error {
+	// YAML is a superset of JSON: decoding the JSON as a YAML node keeps
+	// the order of the keys
+	var node yaml.Node
+	if err := yaml.Unmarshal(value, &node); err != nil {
+		return err
+	}
+	resetYAMLStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// resetYAMLStyle switches the flow style and the quotes of decoded JSON
+// to the default YAML style
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+func writeTable(w io.Writer, columns []string, rows [][]string) error {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, columns []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(columns); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+
+	return cw.Error()
+}
+
+// isListObject reports whether the value is a list or search result
+func isListObject(value gjson.Result) bool {
+	object := value.Get("object").String()
+	return (object == "list" || object == "search_result") && value.Get("data").IsArray()
+}
+
+// scalarKeys returns the top-level keys of an object whose values are not
+// objects or arrays
+func scalarKeys(object gjson.Result) []string {
+	var keys []string
+	object.ForEach(func(key, value gjson.Result) bool {
+		if !value.IsObject() && !value.IsArray() {
+			keys = append(keys, key.String())
+		}
+		return true
+	})
+
+	return keys
+}
+
+// cellValue formats a value for a table or CSV cell
+func cellValue(value gjson.Result) string {
+	switch value.Type {
+	case gjson.Null:
+		return ""
+	case gjson.JSON:
+		return string(pretty.Ugly([]byte(value.Raw)))
+	default:
+		return value.String()
+	}
+}
+
+func rawOrNull(value gjson.Result) string {
+	if !value.Exists() {
+		return "null"
+	}
+
+	return value.Raw
+}
@@ -0,0 +1,78 @@
+package requests
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const customerList = `{
+  "object": "list",
+  "data": [
+    {"id": "cus_1", "object": "customer", "email": "fry@example.com", "created": 1600000000, "metadata": {"crew": "yes"}, "address": null},
+    {"id": "cus_2", "object": "customer", "email": "leela@example.com", "created": 1600000001, "metadata": {}, "address": {"city": "New New York"}}
+  ],
+  "has_more": false,
+  "url": "/v1/customers"
+}`
+
+func writeOutput(t *testing.T, o output, body string) string {
+	var out bytes.Buffer
+	require.NoError(t, o.write(&out, []byte(body), false))
+	return out.String()
+}
+
+func TestOutputRaw(t *testing.T) {
+	require.Equal(t, customerList, writeOutput(t, output{format: FormatJSON}, customerList))
+}
+
+func TestOutputFieldsJSON(t *testing.T) {
+	out := writeOutput(t, output{fields: []string{"id", "address.city"}}, customerList)
+	require.JSONEq(t, `[{"id": "cus_1", "address.city": null}, {"id": "cus_2", "address.city": "New New York"}]`, out)
+}
+
+func TestOutputQuery(t *testing.T) {
+	out := writeOutput(t, output{selection: "data.#.email"}, customerList)
+	require.JSONEq(t, `["fry@example.com", "leela@example.com"]`, out)
+}
+
+func TestOutputNDJSON(t *testing.T) {
+	out := writeOutput(t, output{format: FormatNDJSON, fields: []string{"id", "created"}}, customerList)
+	require.Equal(t, "{\"id\":\"cus_1\",\"created\":1600000000}\n{\"id\":\"cus_2\",\"created\":1600000001}\n", out)
+}
+
+func TestOutputYAML(t *testing.T) {
+	out := writeOutput(t, output{format: FormatYAML}, `{"id": "cus_1", "balance": 0, "description": "123", "metadata": {"crew": "yes"}, "tags": []}`)
+	require.Equal(t, `id: cus_1
+balance: 0
+description: "123"
+metadata:
+  crew: yes
+tags: []
+`, out)
+}
+
+func TestOutputTable(t *testing.T) {
+	out := writeOutput(t, output{format: FormatTable}, customerList)
+	require.Equal(t, `ID     OBJECT    EMAIL              CREATED     ADDRESS
+cus_1  customer  fry@example.com    1600000000  
+cus_2  customer  leela@example.com  1600000001  {"city":"New New York"}
+`, out)
+}
+
+func TestOutputCSV(t *testing.T) {
+	out := writeOutput(t, output{format: FormatCSV, fields: []string{"id", "email", "metadata.crew"}}, customerList)
+	require.Equal(t, "id,email,metadata.crew\ncus_1,fry@example.com,yes\ncus_2,leela@example.com,\n", out)
+}
+
+func TestOutputTableSingleObject(t *testing.T) {
+	out := writeOutput(t, output{format: FormatTable, fields: []string{"id", "email"}}, `{"id": "cus_1", "email": "fry@example.com"}`)
+	require.Equal(t, "ID     EMAIL\ncus_1  fry@example.com\n", out)
+}
+
+func TestOutputValidate(t *testing.T) {
+	require.NoError(t, output{}.validate())
+	require.NoError(t, output{format: FormatCSV}.validate())
+	require.EqualError(t, output{format: "xml"}.validate(), "unsupported format: xml. Supported formats are: json, yaml, table, csv, ndjson")
+}
@@ -0,0 +1,150 @@
+package requests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// maxPageSize is the largest page size that list endpoints accept
+const maxPageSize = 100
+
+// pagination holds the flags of list requests that fetch several pages
+type pagination struct {
+	all      bool
+	maxItems int
+}
+
+// Paginating reports whether the request should follow the pages of a
+// list, i.e. whether --all or --max-items was set
+func (rb *Base) Paginating() bool {
+	return rb.pagination.all || rb.pagination.maxItems > 0
+}
+
+// MakePaginatedRequest makes a list request and follows its pages until
+// every object (with --all) or the requested number of objects (with
+// --max-items) was retrieved. The objects are written to stdout in the
+// output format once every page was received, or as each page is received
+// with NDJSON. The selection and the fields apply to each object of the list.
+func (rb *Base) MakePaginatedRequest(ctx context.Context, apiKey, path string, params *RequestParameters) error {
+	return rb.paginate(ctx, apiKey, path, params, os.Stdout)
+}
+
+func (rb *Base) paginate(ctx context.Context, apiKey, path string, params *RequestParameters, w io.Writer) error {
+	if err := rb.output.validate(); err != nil {
+		return err
+	}
+	streaming := rb.output.format == FormatNDJSON
+
+	// Pages are requested without printing them
+	pageReq := *rb
+	pageReq.SuppressOutput = true
+
+	pageParams := *params
+	listParams := paginationParams(&pageParams)
+	pageParams.data = listParams
+	backwards := pageParams.endingBefore != ""
+	if backwards {
+		pageParams.startingAfter = ""
+	}
+
+	pageSize := maxPageSize
+	if pageParams.limit != "" {
+		limit, err := strconv.Atoi(pageParams.limit)
+		if err != nil {
+			return fmt.Errorf("invalid limit: %s", pageParams.limit)
+		}
+		pageSize = limit
+	}
+
+	var objects []gjson.Result
+	count := 0
+
+	for {
+		if rb.pagination.maxItems > 0 && rb.pagination.maxItems-count < pageSize {
+			pageSize = rb.pagination.maxItems - count
+		}
+		pageParams.limit = strconv.Itoa(pageSize)
+
+		body, err := pageReq.MakeRequest(ctx, apiKey, path, &pageParams, true)
+		if err != nil {
+			return err
+		}
+
+		page := gjson.ParseBytes(body)
+		if object := page.Get("object").String(); object != "list" && object != "search_result" {
+			return fmt.Errorf("--all and --max-items can only be used with list requests")
+		}
+
+		data := page.Get("data").Array()
+
+		pageObjects := data
+		if rb.output.selection != "" {
+			pageObjects = make([]gjson.Result, len(data))
+			for i, object := range data {
+				pageObjects[i] = object.Get(rb.output.selection)
+			}
+		}
+
+		if streaming {
+			if err := rb.output.writeObjects(w, pageObjects, true, rb.DarkStyle); err != nil {
+				return err
+			}
+		} else {
+			objects = append(objects, pageObjects...)
+		}
+		count += len(data)
+
+		if !page.Get("has_more").Bool() || len(data) == 0 || (rb.pagination.maxItems > 0 && count >= rb.pagination.maxItems) {
+			break
+		}
+
+		switch {
+		case page.Get("next_page").String() != "":
+			// Search results are paginated with a page token
+			pageParams.data = append(append([]string{}, listParams...), "page="+page.Get("next_page").String())
+		case backwards:
+			pageParams.endingBefore = data[0].Get("id").String()
+		default:
+			pageParams.startingAfter = data[len(data)-1].Get("id").String()
+		}
+	}
+
+	if streaming {
+		return nil
+	}
+
+	return rb.output.writeObjects(w, objects, true, rb.DarkStyle)
+}
+
+// paginationParams takes the page size and the cursors out of the data of
+// params, where the flags of generated list commands put them, and returns
+// the remaining data. Pagination sets them on every page instead.
+func paginationParams(params *RequestParameters) []string {
+	data := make([]string, 0, len(params.data))
+	for _, datum := range params.data {
+		split := strings.SplitN(datum, "=", 2)
+		if len(split) < 2 {
+			data = append(data, datum)
+			continue
+		}
+
+		switch split[0] {
+		case "limit":
+			params.limit = split[1]
+		case "starting_after":
+			params.startingAfter = split[1]
+		case "ending_before":
+			params.endingBefore = split[1]
+		default:
+			data = append(data, datum)
+		}
+	}
+
+	return data
+}
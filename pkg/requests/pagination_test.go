@@ -0,0 +1,151 @@
+package requests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// listServer serves a list of `total` customers, cus_1 to cus_N
+func listServer(t *testing.T, total int, queries *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		require.NoError(t, err)
+
+		start := 0
+		if after := r.URL.Query().Get("starting_after"); after != "" {
+			_, err := fmt.Sscanf(after, "cus_%d", &start)
+			require.NoError(t, err)
+		}
+
+		data := []map[string]string{}
+		for i := start + 1; i <= total && len(data) < limit; i++ {
+			data = append(data, map[string]string{"id": fmt.Sprintf("cus_%d", i)})
+		}
+
+		if before := r.URL.Query().Get("ending_before"); before != "" {
+			end := 0
+			_, err := fmt.Sscanf(before, "cus_%d", &end)
+			require.NoError(t, err)
+
+			start = end - 1 - limit
+			if start < 0 {
+				start = 0
+			}
+
+			data = []map[string]string{}
+			for i := start + 1; i < end; i++ {
+				data = append(data, map[string]string{"id": fmt.Sprintf("cus_%d", i)})
+			}
+
+			json.NewEncoder(w).Encode(map[string]interface{}{"object": "list", "data": data, "has_more": start > 0})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"object":   "list",
+			"data":     data,
+			"has_more": start+len(data) < total,
+		})
+	}))
+}
+
+func TestPaginateAll(t *testing.T) {
+	var queries []string
+	ts := listServer(t, 5, &queries)
+	defer ts.Close()
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodGet}
+	rb.pagination.all = true
+
+	var out bytes.Buffer
+	err := rb.paginate(context.Background(), "sk_test_1234", "/v1/customers", &RequestParameters{limit: "2"}, &out)
+	require.NoError(t, err)
+
+	var items []map[string]string
+	require.NoError(t, json.Unmarshal(out.Bytes(), &items))
+	require.Len(t, items, 5)
+	require.Equal(t, "cus_5", items[4]["id"])
+	require.Equal(t, []string{"limit=2", "limit=2&starting_after=cus_2", "limit=2&starting_after=cus_4"}, queries)
+}
+
+func TestPaginateMaxItemsNDJSON(t *testing.T) {
+	var queries []string
+	ts := listServer(t, 250, &queries)
+	defer ts.Close()
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodGet}
+	rb.pagination.maxItems = 150
+	rb.output.format = FormatNDJSON
+
+	var out bytes.Buffer
+	err := rb.paginate(context.Background(), "sk_test_1234", "/v1/customers", &RequestParameters{}, &out)
+	require.NoError(t, err)
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 150)
+	require.Equal(t, `{"id":"cus_150"}`, string(lines[149]))
+	require.Equal(t, []string{"limit=100", "limit=50&starting_after=cus_100"}, queries)
+}
+
+func TestPaginateNotAList(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "cus_1", "object": "customer"}`))
+	}))
+	defer ts.Close()
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodGet}
+	rb.pagination.all = true
+
+	var out bytes.Buffer
+	err := rb.paginate(context.Background(), "sk_test_1234", "/v1/customers/cus_1", &RequestParameters{}, &out)
+	require.EqualError(t, err, "--all and --max-items can only be used with list requests")
+}
+
+func TestPaginateCursorParams(t *testing.T) {
+	var queries []string
+	ts := listServer(t, 5, &queries)
+	defer ts.Close()
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodGet}
+	rb.pagination.all = true
+
+	// Generated list commands send the pagination params as data
+	params := &RequestParameters{data: []string{"limit=2", "starting_after=cus_1", "email=jenny@example.com"}}
+
+	var out bytes.Buffer
+	err := rb.paginate(context.Background(), "sk_test_1234", "/v1/customers", params, &out)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"email=jenny%40example.com&limit=2&starting_after=cus_1",
+		"email=jenny%40example.com&limit=2&starting_after=cus_3",
+	}, queries)
+}
+
+func TestPaginateEndingBeforeParam(t *testing.T) {
+	var queries []string
+	ts := listServer(t, 5, &queries)
+	defer ts.Close()
+
+	rb := Base{APIBaseURL: ts.URL, Method: http.MethodGet}
+	rb.pagination.all = true
+
+	params := &RequestParameters{data: []string{"limit=2", "ending_before=cus_5"}}
+
+	var out bytes.Buffer
+	err := rb.paginate(context.Background(), "sk_test_1234", "/v1/customers", params, &out)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"limit=2&ending_before=cus_5",
+		"limit=2&ending_before=cus_3",
+	}, queries)
+}
@@ -0,0 +1,173 @@
+package requests
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+)
+
+// The IDs of the objects returned by the requests of CLI commands are kept
+// in a cache of the config folder, so that they can be offered when a
+// command expects an ID. The cache keeps the most recent IDs of every type
+// of object, for each profile. The IDs of deleted objects are removed from
+// it.
+
+const (
+	recentObjectIDsFile = "recent_object_ids.json"
+	maxRecentObjectIDs  = 20
+)
+
+var recentObjectIDsMutex sync.Mutex
+
+// recentObjectIDs maps profile names to object types to IDs, most recent
+// first
+type recentObjectIDs map[string]map[string][]string
+
+// RecentObjectIDs returns the IDs of the objects of a type, e.g. `customer`,
+// most recently returned by the API for a profile, most recent first
+func RecentObjectIDs(profileName, objectType string) []string {
+	recentObjectIDsMutex.Lock()
+	defer recentObjectIDsMutex.Unlock()
+
+	return readRecentObjectIDs(recentObjectIDsPath())[profileName][objectType]
+}
+
+// recordObjectIDs adds the ID of the object of a response, or the IDs of
+// the objects of a list, to the cache, and removes the IDs of deleted
+// objects from it
+func (rb *Base) recordObjectIDs(body []byte) {
+	if !rb.isCommandRequest() || rb.Profile == nil {
+		return
+	}
+
+	response := gjson.ParseBytes(body)
+	objects := []gjson.Result{response}
+	if isListObject(response) {
+		objects = response.Get("data").Array()
+	}
+
+	var found, deleted [][2]string
+	for _, object := range objects {
+		id := object.Get("id").String()
+		objectType := object.Get("object").String()
+		if id == "" || objectType == "" {
+			continue
+		}
+
+		if object.Get("deleted").Bool() {
+			deleted = append(deleted, [2]string{objectType, id})
+		} else {
+			found = append(found, [2]string{objectType, id})
+		}
+	}
+
+	if len(found) == 0 && len(deleted) == 0 {
+		return
+	}
+
+	if err := updateRecentObjectIDs(recentObjectIDsPath(), rb.Profile.ProfileName, found, deleted); err != nil {
+		log.WithFields(log.Fields{
+			"prefix": "requests.Base.recordObjectIDs",
+		}).Debugf("Failed to cache object IDs: %v", err)
+	}
+}
+
+func recentObjectIDsPath() string {
+	return filepath.Join(config.Folder(), recentObjectIDsFile)
+}
+
+func readRecentObjectIDs(file string) recentObjectIDs {
+	cache := make(recentObjectIDs)
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return cache
+	}
+
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return make(recentObjectIDs)
+	}
+
+	return cache
+}
+
+// updateRecentObjectIDs adds (object type, ID) pairs to the cache and
+// removes the deleted ones. The first pairs are the most recent ones, as in
+// the lists of the API.
+func updateRecentObjectIDs(file, profileName string, objects, deleted [][2]string) error {
+	recentObjectIDsMutex.Lock()
+	defer recentObjectIDsMutex.Unlock()
+
+	cache := readRecentObjectIDs(file)
+	if cache[profileName] == nil {
+		cache[profileName] = make(map[string][]string)
+	}
+
+	for i := len(objects) - 1; i >= 0; i-- {
+		objectType, id := objects[i][0], objects[i][1]
+
+		ids := []string{id}
+		for _, recent := range cache[profileName][objectType] {
+			if recent != id && len(ids) < maxRecentObjectIDs {
+				ids = append(ids, recent)
+			}
+		}
+		cache[profileName][objectType] = ids
+	}
+
+	for _, object := range deleted {
+		objectType, id := object[0], object[1]
+
+		var ids []string
+		for _, recent := range cache[profileName][objectType] {
+			if recent != id {
+				ids = append(ids, recent)
+			}
+		}
+
+		if len(ids) > 0 {
+			cache[profileName][objectType] = ids
+		} else {
+			delete(cache[profileName], objectType)
+		}
+	}
+
+	content, err := json.Marshal(cache)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(file, content)
+}
+
+// writeFileAtomic writes a file through a temporary file that is renamed,
+// so that concurrent CLI processes never read a partially written cache.
+// The last process to write wins.
+func writeFileAtomic(file string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), file)
+}
@@ -0,0 +1,76 @@
+package requests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+)
+
+func TestRecordObjectIDs(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	rb := Base{Profile: &config.Profile{ProfileName: "default"}, Cmd: &cobra.Command{}}
+	rb.recordObjectIDs([]byte(`{"id": "cus_1", "object": "customer"}`))
+	rb.recordObjectIDs([]byte(`{"object": "list", "data": [
+		{"id": "cus_3", "object": "customer"},
+		{"id": "cus_2", "object": "customer"},
+		{"id": "pm_1", "object": "payment_method"}
+	]}`))
+	rb.recordObjectIDs([]byte(`{"id": "cus_2", "object": "customer"}`))
+
+	require.Equal(t, []string{"cus_2", "cus_3", "cus_1"}, RecentObjectIDs("default", "customer"))
+	require.Equal(t, []string{"pm_1"}, RecentObjectIDs("default", "payment_method"))
+	require.Empty(t, RecentObjectIDs("other", "customer"))
+}
+
+func TestRecordDeletedObjectIDs(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	rb := Base{Profile: &config.Profile{ProfileName: "default"}, Cmd: &cobra.Command{}}
+	rb.recordObjectIDs([]byte(`{"id": "cus_1", "object": "customer"}`))
+	rb.recordObjectIDs([]byte(`{"id": "cus_2", "object": "customer"}`))
+	rb.recordObjectIDs([]byte(`{"id": "pm_1", "object": "payment_method"}`))
+	rb.recordObjectIDs([]byte(`{"id": "cus_2", "object": "customer", "deleted": true}`))
+	rb.recordObjectIDs([]byte(`{"id": "pm_1", "object": "payment_method", "deleted": true}`))
+
+	require.Equal(t, []string{"cus_1"}, RecentObjectIDs("default", "customer"))
+	require.Empty(t, RecentObjectIDs("default", "payment_method"))
+
+	// The cache is written through a temporary file
+	files, err := os.ReadDir(filepath.Dir(recentObjectIDsPath()))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestRecordObjectIDsSuppressedOutput(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	// The IDs of the requests of commands are recorded even when their
+	// output is suppressed, as for the rows of --from-file
+	rb := Base{Profile: &config.Profile{ProfileName: "default"}, Cmd: &cobra.Command{}, SuppressOutput: true}
+	rb.recordObjectIDs([]byte(`{"id": "cus_1", "object": "customer"}`))
+
+	// but not the ones of requests made on behalf of other commands
+	other := Base{Profile: &config.Profile{ProfileName: "default"}, SuppressOutput: true}
+	other.recordObjectIDs([]byte(`{"id": "cus_2", "object": "customer"}`))
+
+	require.Equal(t, []string{"cus_1"}, RecentObjectIDs("default", "customer"))
+}
+
+func TestUpdateRecentObjectIDsLimit(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "stripe", recentObjectIDsFile)
+
+	for i := 0; i < maxRecentObjectIDs+5; i++ {
+		require.NoError(t, updateRecentObjectIDs(file, "default", [][2]string{{"customer", fmt.Sprintf("cus_%d", i)}}, nil))
+	}
+
+	ids := readRecentObjectIDs(file)["default"]["customer"]
+	require.Len(t, ids, maxRecentObjectIDs)
+	require.Equal(t, fmt.Sprintf("cus_%d", maxRecentObjectIDs+4), ids[0])
+}
@@ -0,0 +1,79 @@
+package requests
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+)
+
+// SessionLogEnv is the environment variable that enables the recording of
+// requests. When it is set to a file path, every request made by a CLI
+// command (`stripe get`, `stripe post`, resource commands, ...) is appended
+// to that file, which `stripe fixtures export-session` turns into a
+// fixture, including the pages of --all and the rows of --from-file.
+// Requests made on behalf of other commands, such as the ones of fixtures
+// and triggers, are not recorded.
+const SessionLogEnv = "STRIPE_CLI_SESSION_LOG"
+
+// RecordedRequest is an entry of the session log
+type RecordedRequest struct {
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	Path       string    `json:"path"`
+	Params     []string  `json:"params,omitempty"`
+	ResponseID string    `json:"response_id,omitempty"`
+	Object     string    `json:"object,omitempty"`
+}
+
+// recordRequest appends a successful request to the session log, if
+// recording is enabled
+func (rb *Base) recordRequest(path string, params *RequestParameters, body []byte) {
+	logFile := os.Getenv(SessionLogEnv)
+	if logFile == "" || !rb.isCommandRequest() {
+		return
+	}
+
+	entry := RecordedRequest{
+		Time:       time.Now().UTC(),
+		Method:     strings.ToUpper(rb.Method),
+		Path:       path,
+		Params:     params.data,
+		ResponseID: gjson.GetBytes(body, "id").String(),
+		Object:     gjson.GetBytes(body, "object").String(),
+	}
+
+	if err := appendRecordedRequest(logFile, entry); err != nil {
+		log.WithFields(log.Fields{
+			"prefix": "requests.Base.recordRequest",
+			"path":   logFile,
+		}).Debugf("Failed to record request: %v", err)
+	}
+}
+
+// isCommandRequest returns whether the request is made by a CLI command,
+// as opposed to the requests made on behalf of fixtures, triggers or
+// plugins, which are not bound to a command. The output of the requests of
+// commands can be suppressed, as for the pages of --all.
+func (rb *Base) isCommandRequest() bool {
+	return rb.Cmd != nil
+}
+
+func appendRecordedRequest(logFile string, entry RecordedRequest) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = f.Write(append(line, '\n'))
+	return err
+}
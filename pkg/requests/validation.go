@@ -0,0 +1,90 @@
+package requests
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/stripe/stripe-cli/pkg/config"
+	"github.com/stripe/stripe-cli/pkg/spec"
+)
+
+// OpenAPISpecEnv is the environment variable pointing to the OpenAPI spec
+// that params are validated against before requests are sent, e.g. a copy
+// of spec3.sdk.json from https://github.com/stripe/openapi. When it is not
+// set, the spec3.sdk.json file of the config folder is used if it exists.
+// Params are not validated when no spec is available.
+const OpenAPISpecEnv = "STRIPE_CLI_OPENAPI_SPEC"
+
+var (
+	loadSpecOnce sync.Once
+	loadedSpec   *spec.Spec
+
+	logNoSpecOnce sync.Once
+)
+
+// openAPISpecFile returns the path of the OpenAPI spec to validate params
+// against, or an empty string if there is none
+func openAPISpecFile() string {
+	if file := os.Getenv(OpenAPISpecEnv); file != "" {
+		return file
+	}
+
+	file := filepath.Join(config.Folder(), "spec3.sdk.json")
+	if _, err := os.Stat(file); err != nil {
+		return ""
+	}
+
+	return file
+}
+
+// runtimeSpec loads the OpenAPI spec once
+func runtimeSpec() *spec.Spec {
+	loadSpecOnce.Do(func() {
+		file := openAPISpecFile()
+		if file == "" {
+			return
+		}
+
+		api, err := spec.LoadSpec(file)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"prefix": "requests.runtimeSpec",
+				"path":   file,
+			}).Debugf("Failed to load the OpenAPI spec, params won't be validated: %v", err)
+			return
+		}
+
+		loadedSpec = api
+	})
+
+	return loadedSpec
+}
+
+// ValidateParams checks the params of a request against the OpenAPI spec,
+// unless --skip-validation was set or no spec is available
+func (rb *Base) ValidateParams(path string, params *RequestParameters) error {
+	if rb.skipValidation {
+		return nil
+	}
+
+	api := runtimeSpec()
+	if api == nil {
+		logNoSpecOnce.Do(func() {
+			log.WithFields(log.Fields{
+				"prefix": "requests.Base.ValidateParams",
+			}).Debugf("Params are not validated since no OpenAPI spec is available. Set %s to the path of spec3.sdk.json, or copy it to %s, to validate them", OpenAPISpecEnv, config.Folder())
+		})
+		return nil
+	}
+
+	return api.ValidateParams(rb.Method, path, params.data)
+}
+
+// OpenAPISpec returns the OpenAPI spec set with STRIPE_CLI_OPENAPI_SPEC or
+// found in the config folder, or nil if there is none
+func OpenAPISpec() *spec.Spec {
+	return runtimeSpec()
+}
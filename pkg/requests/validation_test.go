@@ -0,0 +1,46 @@
+package requests
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateParams(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "spec3.sdk.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{
+  "paths": {
+    "/v1/customers": {
+      "post": {
+        "requestBody": {
+          "content": {
+            "application/x-www-form-urlencoded": {
+              "schema": {"type": "object", "properties": {"email": {"type": "string"}}}
+            }
+          }
+        }
+      }
+    }
+  }
+}`), 0600))
+	t.Setenv(OpenAPISpecEnv, file)
+
+	loadSpecOnce = sync.Once{}
+	defer func() {
+		loadSpecOnce = sync.Once{}
+		loadedSpec = nil
+	}()
+
+	rb := Base{Method: http.MethodPost}
+	params := &RequestParameters{data: []string{"emial=fry@example.com"}}
+
+	err := rb.ValidateParams("/v1/customers", params)
+	require.EqualError(t, err, "invalid parameters for POST /v1/customers:\n  - unknown parameter emial. Did you mean email?")
+
+	rb.skipValidation = true
+	require.NoError(t, rb.ValidateParams("/v1/customers", params))
+}
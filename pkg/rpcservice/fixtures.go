@@ -3,14 +3,17 @@ package rpcservice
 import (
 	"context"
 
+	"github.com/spf13/afero"
+
 	"github.com/stripe/stripe-cli/pkg/fixtures"
 	"github.com/stripe/stripe-cli/rpc"
 )
 
 // Fixture returns the default fixture of given event in string format
 func (srv *RPCService) Fixture(ctx context.Context, req *rpc.FixtureRequest) (*rpc.FixtureResponse, error) {
-	fixtureFilename := fixtures.Events[req.Event]
-	f, err := fixtures.NewFixtureFromFile(nil, "", "", "", fixtureFilename, []string{}, []string{}, []string{}, []string{})
+	fs := afero.NewOsFs()
+	fixtureFilename, _ := fixtures.TriggerFile(fs, req.Event)
+	f, err := fixtures.NewFixtureFromFile(fs, "", "", "", fixtureFilename, []string{}, []string{}, []string{}, []string{})
 	if err != nil {
 		return &rpc.FixtureResponse{Fixture: ""}, err
 	}
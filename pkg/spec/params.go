@@ -0,0 +1,131 @@
+package spec
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// maxParamDepth is the depth up to which nested params are listed, e.g.
+// `shipping[address][line1]`
+const maxParamDepth = 3
+
+// Param is a param of an operation, which can be nested such as
+// `shipping[name]` or `line_items[0][price]`
+type Param struct {
+	Name        string
+	Description string
+	Required    bool
+
+	// Schema is the schema of the value of the param. Params whose schema
+	// is an object with properties are listed as their nested params.
+	Schema *Schema
+}
+
+// OperationParams returns the params of the operation of the spec matching
+// the method and the path, required params first. The path can contain
+// placeholders, e.g. `/v1/customers/{customer}`. Objects are listed as
+// their nested params, and lists of objects as the nested params of their
+// first item. It returns nil if no operation matches.
+func (s *Spec) OperationParams(method, path string) []Param {
+	method = strings.ToLower(method)
+
+	op := s.findOperation(method, path)
+	if op == nil {
+		return nil
+	}
+
+	var params []Param
+
+	if method == strings.ToLower(http.MethodPost) {
+		schema := s.resolve(s.paramsSchema(method, op))
+		if schema == nil {
+			return nil
+		}
+
+		for name, propSchema := range schema.Properties {
+			params = append(params, s.flattenParam(name, propSchema.Description, isRequired(schema, name), propSchema, 1)...)
+		}
+	} else {
+		for _, param := range op.Parameters {
+			if param.In != ParameterQuery {
+				continue
+			}
+
+			params = append(params, s.flattenParam(param.Name, param.Description, param.Required, param.Schema, 1)...)
+		}
+	}
+
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].Required != params[j].Required {
+			return params[i].Required
+		}
+		return params[i].Name < params[j].Name
+	})
+
+	return params
+}
+
+func (s *Spec) flattenParam(name, description string, required bool, schema *Schema, depth int) []Param {
+	schema = s.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if description == "" {
+		description = schema.Description
+	}
+
+	if depth < maxParamDepth {
+		object, prefix := s.nestedObject(schema)
+		if object != nil {
+			var params []Param
+			for key, propSchema := range object.Properties {
+				params = append(params, s.flattenParam(fmt.Sprintf("%s%s[%s]", name, prefix, key), propSchema.Description, required && isRequired(object, key), propSchema, depth+1)...)
+			}
+			return params
+		}
+	}
+
+	return []Param{{
+		Name:        name,
+		Description: description,
+		Required:    required,
+		Schema:      schema,
+	}}
+}
+
+// nestedObject returns the object with properties that a schema, or one of
+// its alternatives, describes, and the key prefix of its properties: "" for
+// objects and "[0]" for lists of objects
+func (s *Spec) nestedObject(schema *Schema) (*Schema, string) {
+	for _, alternative := range append([]*Schema{schema}, schema.AnyOf...) {
+		alternative = s.resolve(alternative)
+		if alternative == nil {
+			continue
+		}
+
+		if alternative.Type == TypeObject && len(alternative.Properties) > 0 {
+			return alternative, ""
+		}
+
+		if alternative.Type == TypeArray {
+			if items := s.resolve(alternative.Items); items != nil && items.Type == TypeObject && len(items.Properties) > 0 {
+				return items, "[0]"
+			}
+		}
+	}
+
+	return nil, ""
+}
+
+func isRequired(schema *Schema, name string) bool {
+	for _, required := range schema.Required {
+		if required == name {
+			return true
+		}
+	}
+
+	return false
+}
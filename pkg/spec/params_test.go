@@ -0,0 +1,47 @@
+package spec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationParams(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	params := api.OperationParams("POST", "/v1/customers")
+
+	names := make([]string, len(params))
+	for i, param := range params {
+		names[i] = param.Name
+	}
+	require.Equal(t, []string{
+		"address[city]",
+		"address[line1]",
+		"balance",
+		"email",
+		"metadata",
+		"preferred_locales",
+		"tax_exempt",
+		"validate",
+	}, names)
+	require.Equal(t, TypeInteger, params[2].Schema.Type)
+	require.Equal(t, []interface{}{"exempt", "none", "reverse"}, params[6].Schema.Enum)
+}
+
+func TestOperationParamsRequiredFirst(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	params := api.OperationParams("GET", "/v1/customers/search")
+
+	require.Len(t, params, 1)
+	require.Equal(t, "query", params[0].Name)
+	require.True(t, params[0].Required)
+}
+
+func TestOperationParamsPathPlaceholders(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	require.Empty(t, api.OperationParams("GET", "/v1/customers/{customer}"))
+	require.Nil(t, api.OperationParams("DELETE", "/v1/customers/{customer}"))
+}
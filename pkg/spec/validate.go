@@ -0,0 +1,360 @@
+package spec
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// formContentType is the content type of the request bodies of the API
+const formContentType = "application/x-www-form-urlencoded"
+
+// ValidationError lists the problems found in the params of a request
+type ValidationError struct {
+	Method   string
+	Path     string
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid parameters for %s %s:\n  - %s", e.Method, e.Path, strings.Join(e.Problems, "\n  - "))
+}
+
+// paramNode is a param value, or the nested params of a param such as
+// `metadata[plan]`
+type paramNode struct {
+	value    *string
+	children map[string]*paramNode
+	keys     []string
+}
+
+// ValidateParams checks form encoded params (`a[b]=c`) against the schema
+// of the operation of the spec matching the method and the path: their
+// names must be known, and their values must have the right type and be
+// one of the enum values. Required params must be present. Requests that
+// don't match an operation of the spec are not validated.
+func (s *Spec) ValidateParams(method, path string, params []string) error {
+	method = strings.ToLower(method)
+
+	op := s.findOperation(method, path)
+	if op == nil {
+		return nil
+	}
+
+	schema := s.paramsSchema(method, op)
+	if schema == nil {
+		return nil
+	}
+
+	root := &paramNode{children: make(map[string]*paramNode)}
+	for _, param := range params {
+		split := strings.SplitN(param, "=", 2)
+		if len(split) < 2 {
+			continue
+		}
+		root.add(parseParamKey(split[0]), split[1])
+	}
+
+	problems := s.validateNode(root, schema, "")
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Method: strings.ToUpper(method), Path: path, Problems: problems}
+}
+
+// findOperation returns the operation whose path matches the request path.
+// Literal path segments take precedence over path params, e.g.
+// `/v1/customers/search` over `/v1/customers/{customer}`.
+func (s *Spec) findOperation(method, path string) *Operation {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	var match *Operation
+	bestScore := -1
+
+	for specPath, verbs := range s.Paths {
+		op, ok := verbs[HTTPVerb(method)]
+		if !ok || op == nil {
+			continue
+		}
+
+		specSegments := strings.Split(strings.Trim(string(specPath), "/"), "/")
+		if len(specSegments) != len(segments) {
+			continue
+		}
+
+		score := 0
+		for i, segment := range specSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				continue
+			}
+			if segment != segments[i] {
+				score = -1
+				break
+			}
+			score++
+		}
+
+		if score > bestScore {
+			match = op
+			bestScore = score
+		}
+	}
+
+	return match
+}
+
+// paramsSchema returns the schema of the params of an operation: its
+// request body for POST requests, and its query params otherwise
+func (s *Spec) paramsSchema(method string, op *Operation) *Schema {
+	if method == strings.ToLower(http.MethodPost) {
+		if op.RequestBody == nil {
+			return nil
+		}
+
+		media, ok := op.RequestBody.Content[formContentType]
+		if !ok {
+			return nil
+		}
+
+		return media.Schema
+	}
+
+	schema := &Schema{Type: TypeObject, Properties: make(map[string]*Schema)}
+	for _, param := range op.Parameters {
+		if param.In != ParameterQuery {
+			continue
+		}
+
+		schema.Properties[param.Name] = param.Schema
+		if param.Required {
+			schema.Required = append(schema.Required, param.Name)
+		}
+	}
+
+	return schema
+}
+
+// resolve follows the reference of a schema to the components
+func (s *Spec) resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		name := strings.TrimPrefix(schema.Ref, "#/components/schemas/")
+		schema = s.Components.Schemas[name]
+	}
+
+	return schema
+}
+
+func (s *Spec) validateNode(node *paramNode, schema *Schema, name string) []string {
+	schema = s.resolve(schema)
+	if schema == nil {
+		return nil
+	}
+
+	if len(schema.AnyOf) > 0 {
+		// The param is valid if it matches any of the schemas. Otherwise,
+		// report the problems of the closest schema.
+		var closest []string
+		for i, alternative := range schema.AnyOf {
+			problems := s.validateNode(node, alternative, name)
+			if len(problems) == 0 {
+				return nil
+			}
+			if i == 0 || len(problems) < len(closest) {
+				closest = problems
+			}
+		}
+
+		return closest
+	}
+
+	if node.value != nil {
+		return validateValue(*node.value, schema, name)
+	}
+
+	switch schema.Type {
+	case TypeArray:
+		var problems []string
+		for _, key := range node.keys {
+			if _, err := strconv.Atoi(key); key != "" && err != nil {
+				problems = append(problems, fmt.Sprintf("%s is a list: use %s[] or %s[0] instead of %s[%s]", name, name, name, name, key))
+				continue
+			}
+			problems = append(problems, s.validateNode(node.children[key], schema.Items, fmt.Sprintf("%s[%s]", name, key))...)
+		}
+		return problems
+	case TypeObject, "":
+		var problems []string
+		for _, key := range node.keys {
+			childName := key
+			if name != "" {
+				childName = fmt.Sprintf("%s[%s]", name, key)
+			}
+
+			propSchema, ok := schema.Properties[key]
+			switch {
+			case ok:
+				problems = append(problems, s.validateNode(node.children[key], propSchema, childName)...)
+			case len(schema.Properties) == 0 || allowsAdditionalProperties(schema):
+				// Free-form objects such as metadata
+			default:
+				problems = append(problems, unknownParamProblem(childName, key, schema.Properties))
+			}
+		}
+
+		for _, required := range schema.Required {
+			if _, ok := node.children[required]; !ok {
+				requiredName := required
+				if name != "" {
+					requiredName = fmt.Sprintf("%s[%s]", name, required)
+				}
+				problems = append(problems, fmt.Sprintf("missing required parameter %s", requiredName))
+			}
+		}
+		return problems
+	default:
+		return []string{fmt.Sprintf("%s expects a single value, not nested parameters", name)}
+	}
+}
+
+func validateValue(value string, schema *Schema, name string) []string {
+	invalid := func(expected string) []string {
+		return []string{fmt.Sprintf("invalid value %q for %s: expected %s", value, name, expected)}
+	}
+
+	if len(schema.Enum) > 0 {
+		values := make([]string, len(schema.Enum))
+		for i, e := range schema.Enum {
+			values[i] = fmt.Sprint(e)
+			if values[i] == value {
+				return nil
+			}
+		}
+
+		return invalid("one of " + strings.Join(values, ", "))
+	}
+
+	switch schema.Type {
+	case TypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return invalid("an integer")
+		}
+	case TypeNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return invalid("a number")
+		}
+	case TypeBoolean:
+		if value != "true" && value != "false" {
+			return invalid("true or false")
+		}
+	case TypeArray:
+		return []string{fmt.Sprintf("%s is a list: use %s[]=%s", name, name, value)}
+	case TypeObject:
+		if len(schema.Properties) > 0 || allowsAdditionalProperties(schema) {
+			return []string{fmt.Sprintf("%s is an object: use %s[<key>]=<value>", name, name)}
+		}
+	}
+
+	return nil
+}
+
+func allowsAdditionalProperties(schema *Schema) bool {
+	switch additional := schema.AdditionalProperties.(type) {
+	case nil:
+		return false
+	case bool:
+		return additional
+	default:
+		return true
+	}
+}
+
+func unknownParamProblem(name, key string, properties map[string]*Schema) string {
+	problem := fmt.Sprintf("unknown parameter %s", name)
+
+	var suggestions []string
+	for property := range properties {
+		distance := levenshtein(key, property)
+		if distance <= 2 || distance <= len(key)/3 || strings.HasPrefix(property, key) {
+			suggestions = append(suggestions, property)
+		}
+	}
+
+	if len(suggestions) > 0 {
+		sort.Strings(suggestions)
+		problem += fmt.Sprintf(". Did you mean %s?", strings.Join(suggestions, " or "))
+	}
+
+	return problem
+}
+
+func (n *paramNode) add(keys []string, value string) {
+	key := keys[0]
+	if key == "" {
+		// `a[]=b` appends to a list
+		key = strconv.Itoa(len(n.children))
+	}
+
+	child, ok := n.children[key]
+	if !ok {
+		child = &paramNode{children: make(map[string]*paramNode)}
+		n.children[key] = child
+		n.keys = append(n.keys, key)
+	}
+
+	if len(keys) == 1 {
+		child.value = &value
+		return
+	}
+
+	child.add(keys[1:], value)
+}
+
+// parseParamKey splits a form key such as `a[b][0]` into its components
+func parseParamKey(key string) []string {
+	i := strings.Index(key, "[")
+	if i < 0 {
+		return []string{key}
+	}
+
+	keys := []string{key[:i]}
+	keys = append(keys, strings.Split(strings.TrimSuffix(key[i+1:], "]"), "][")...)
+
+	return keys
+}
+
+// levenshtein returns the edit distance between two strings
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min3(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+
+	return a
+}
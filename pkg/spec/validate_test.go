@@ -0,0 +1,140 @@
+package spec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const validationSpec = `{
+  "components": {
+    "schemas": {
+      "address": {
+        "type": "object",
+        "properties": {
+          "city": {"type": "string"},
+          "line1": {"type": "string"}
+        },
+        "required": ["line1"]
+      }
+    }
+  },
+  "paths": {
+    "/v1/customers": {
+      "get": {
+        "parameters": [
+          {"in": "query", "name": "email", "schema": {"type": "string"}},
+          {"in": "query", "name": "limit", "schema": {"type": "integer"}}
+        ]
+      },
+      "post": {
+        "requestBody": {
+          "content": {
+            "application/x-www-form-urlencoded": {
+              "schema": {
+                "type": "object",
+                "properties": {
+                  "address": {"anyOf": [{"$ref": "#/components/schemas/address"}, {"type": "string", "enum": [""]}]},
+                  "balance": {"type": "integer"},
+                  "email": {"type": "string"},
+                  "metadata": {"type": "object", "additionalProperties": {"type": "string"}},
+                  "preferred_locales": {"type": "array", "items": {"type": "string"}},
+                  "tax_exempt": {"type": "string", "enum": ["exempt", "none", "reverse"]},
+                  "validate": {"type": "boolean"}
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/v1/customers/search": {
+      "get": {
+        "parameters": [
+          {"in": "query", "name": "query", "required": true, "schema": {"type": "string"}}
+        ]
+      }
+    },
+    "/v1/customers/{customer}": {
+      "get": {
+        "parameters": [
+          {"in": "path", "name": "customer", "required": true, "schema": {"type": "string"}}
+        ]
+      }
+    }
+  }
+}`
+
+func loadValidationSpec(t *testing.T) *Spec {
+	var api Spec
+	require.NoError(t, json.Unmarshal([]byte(validationSpec), &api))
+	return &api
+}
+
+func TestValidateParams(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	require.NoError(t, api.ValidateParams("POST", "/v1/customers", []string{
+		"email=fry@example.com",
+		"balance=-100",
+		"metadata[crew]=yes",
+		"preferred_locales[]=en",
+		"preferred_locales[]=fr",
+		"tax_exempt=none",
+		"validate=true",
+		"address[line1]=Planet Express",
+	}))
+
+	// Unsetting with an empty string
+	require.NoError(t, api.ValidateParams("POST", "/v1/customers", []string{"address="}))
+
+	require.NoError(t, api.ValidateParams("GET", "/v1/customers", []string{"email=fry@example.com"}))
+	require.NoError(t, api.ValidateParams("GET", "/v1/customers/cus_123", nil))
+}
+
+func TestValidateParamsProblems(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	err := api.ValidateParams("POST", "/v1/customers", []string{
+		"emial=fry@example.com",
+		"balance=lots",
+		"tax_exempt=maybe",
+		"validate=yes",
+		"preferred_locales=en",
+		"address[city]=New New York",
+	})
+	require.Error(t, err)
+
+	validationErr, ok := err.(*ValidationError)
+	require.True(t, ok)
+	require.Equal(t, []string{
+		"unknown parameter emial. Did you mean email?",
+		`invalid value "lots" for balance: expected an integer`,
+		`invalid value "maybe" for tax_exempt: expected one of exempt, none, reverse`,
+		`invalid value "yes" for validate: expected true or false`,
+		"preferred_locales is a list: use preferred_locales[]=en",
+		"missing required parameter address[line1]",
+	}, validationErr.Problems)
+	require.Contains(t, err.Error(), "invalid parameters for POST /v1/customers:\n  - unknown parameter emial")
+}
+
+func TestValidateParamsRequired(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	// `/v1/customers/search` takes precedence over `/v1/customers/{customer}`
+	err := api.ValidateParams("GET", "/v1/customers/search", nil)
+	require.EqualError(t, err, "invalid parameters for GET /v1/customers/search:\n  - missing required parameter query")
+}
+
+func TestValidateParamsUnknownOperation(t *testing.T) {
+	api := loadValidationSpec(t)
+
+	require.NoError(t, api.ValidateParams("POST", "/v1/charges", []string{"anything=goes"}))
+}
+
+func TestLevenshtein(t *testing.T) {
+	require.Equal(t, 0, levenshtein("email", "email"))
+	require.Equal(t, 2, levenshtein("emial", "email"))
+	require.Equal(t, 3, levenshtein("", "abc"))
+}
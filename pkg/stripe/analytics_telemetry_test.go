@@ -65,7 +65,7 @@ func TestSetCobraCommandContext(t *testing.T) {
 func TestSetCobraCommandContext_SetsGeneratedResourceForGeneratedCommands(t *testing.T) {
 	parentCmd := &cobra.Command{Annotations: make(map[string]string)}
 
-	oc := resource.NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodGet, map[string]string{}, &config.Config{})
+	oc := resource.NewOperationCmd(parentCmd, "foo", "/v1/bars/{id}", http.MethodGet, map[string]resource.Flag{}, &config.Config{})
 	tel := stripe.NewEventMetadata()
 	tel.SetCobraCommandContext(oc.Cmd)
 	require.True(t, tel.GeneratedResource)
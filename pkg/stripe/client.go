@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/stripe/stripe-cli/pkg/useragent"
@@ -45,6 +46,16 @@ type Client struct {
 	// Defaults to the standard set of relevant for Stripe headers.
 	VerbosePrintableHeaders []string
 
+	// Number of times a request is retried after a connection error, a
+	// 409, 429 or 5xx response, or a response with a `Stripe-Should-Retry:
+	// true` header. POST requests without an idempotency key receive one
+	// when retries are enabled, so that retrying them is safe.
+	MaxRetries int
+
+	// Limits the rate of the requests sent by this client, retries
+	// included. Defaults to the limiter set with SetDefaultRateLimit.
+	RateLimiter *RateLimiter
+
 	// Cached HTTP client, lazily created the first time the Client is used to
 	// send a request.
 	httpClient *http.Client
@@ -52,6 +63,73 @@ type Client struct {
 
 // PerformRequest sends a request to Stripe and returns the response.
 func (c *Client) PerformRequest(ctx context.Context, method, path string, params string, configure func(*http.Request)) (*http.Response, error) {
+	var idempotencyKey string
+	if method == http.MethodPost && c.MaxRetries > 0 {
+		idempotencyKey = uuid.NewString()
+	}
+
+	if c.httpClient == nil {
+		c.httpClient = newHTTPClient(c.Verbose, c.VerbosePrintableHeaders, os.Getenv("STRIPE_CLI_UNIX_SOCKET"))
+	}
+
+	limiter := c.RateLimiter
+	if limiter == nil {
+		limiter = getDefaultRateLimiter()
+	}
+
+	for retry := 0; ; retry++ {
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := c.newRequest(ctx, method, path, params, configure)
+		if err != nil {
+			return nil, err
+		}
+
+		// An idempotency key set by configure takes precedence
+		if idempotencyKey != "" && req.Header.Get("Idempotency-Key") == "" {
+			req.Header.Set("Idempotency-Key", idempotencyKey)
+		}
+
+		resp, err := c.httpClient.Do(req)
+
+		if retry < c.MaxRetries && shouldRetry(ctx, resp, err) {
+			if resp != nil {
+				log.WithFields(log.Fields{
+					"prefix": "stripe.Client.PerformRequest",
+				}).Debugf("Request failed with status %d, retrying", resp.StatusCode)
+				io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			} else {
+				log.WithFields(log.Fields{
+					"prefix": "stripe.Client.PerformRequest",
+				}).Debugf("Request failed with %v, retrying", err)
+			}
+
+			if !sleepBeforeRetry(ctx, retry+1) {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		// RequestID of the API Request
+		requestID := resp.Header.Get("Request-Id")
+		livemode := strings.Contains(c.APIKey, "live")
+		go sendTelemetryEvent(ctx, requestID, livemode)
+		return resp, nil
+	}
+}
+
+// newRequest builds a request. It is called for every attempt, since the
+// body of a request can only be sent once.
+func (c *Client) newRequest(ctx context.Context, method, path string, params string, configure func(*http.Request)) (*http.Request, error) {
 	url, err := url.Parse(path)
 	if err != nil {
 		return nil, err
@@ -84,24 +162,11 @@ func (c *Client) PerformRequest(ctx context.Context, method, path string, params
 		configure(req)
 	}
 
-	if c.httpClient == nil {
-		c.httpClient = newHTTPClient(c.Verbose, c.VerbosePrintableHeaders, os.Getenv("STRIPE_CLI_UNIX_SOCKET"))
-	}
-
 	if ctx != nil {
 		req = req.WithContext(ctx)
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	// RequestID of the API Request
-	requestID := resp.Header.Get("Request-Id")
-	livemode := strings.Contains(c.APIKey, "live")
-	go sendTelemetryEvent(ctx, requestID, livemode)
-	return resp, nil
+	return req, nil
 }
 
 func sendTelemetryEvent(ctx context.Context, requestID string, livemode bool) {
@@ -0,0 +1,99 @@
+package stripe
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket limiting the rate of requests. It is safe
+// to share between goroutines: requests made concurrently wait for their
+// turn.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requestsPerSecond requests per
+// second, with bursts of up to one second worth of requests
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	burst := math.Max(1, math.Floor(requestsPerSecond))
+
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request can be made, or until the context is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait
+// until that token is available. The bucket goes negative when tokens are
+// reserved ahead of time, so that concurrent callers are queued.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+var (
+	defaultRateLimiterMu sync.Mutex
+	defaultRateLimiter   *RateLimiter
+)
+
+// SetDefaultRateLimit limits the rate of the requests made by every
+// Client of the process that doesn't have its own RateLimiter. A rate of
+// zero or less removes the limit.
+func SetDefaultRateLimit(requestsPerSecond float64) {
+	defaultRateLimiterMu.Lock()
+	defer defaultRateLimiterMu.Unlock()
+
+	if requestsPerSecond <= 0 {
+		defaultRateLimiter = nil
+		return
+	}
+
+	defaultRateLimiter = NewRateLimiter(requestsPerSecond)
+}
+
+func getDefaultRateLimiter() *RateLimiter {
+	defaultRateLimiterMu.Lock()
+	defer defaultRateLimiterMu.Unlock()
+
+	return defaultRateLimiter
+}
@@ -0,0 +1,74 @@
+package stripe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := NewRateLimiter(2)
+	now := limiter.last
+
+	// A burst of two requests, then one request every half second
+	require.Equal(t, time.Duration(0), limiter.reserve(now))
+	require.Equal(t, time.Duration(0), limiter.reserve(now))
+	require.Equal(t, 500*time.Millisecond, limiter.reserve(now))
+	require.Equal(t, time.Second, limiter.reserve(now))
+
+	// Tokens are refilled over time
+	require.Equal(t, 500*time.Millisecond, limiter.reserve(now.Add(time.Second)))
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	limiter := NewRateLimiter(0.1)
+	require.NoError(t, limiter.Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, limiter.Wait(ctx), context.Canceled)
+}
+
+func TestPerformRequest_RateLimited(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL)
+	limiter := NewRateLimiter(50)
+
+	start := time.Now()
+
+	// 60 concurrent requests sharing the limiter: 50 in the first burst,
+	// then one every 20ms
+	var wg sync.WaitGroup
+	for i := 0; i < 60; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := Client{BaseURL: baseURL, RateLimiter: limiter}
+			resp, err := client.PerformRequest(context.Background(), http.MethodGet, "/v1/customers", "", nil)
+			require.NoError(t, err)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	require.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+}
+
+func TestSetDefaultRateLimit(t *testing.T) {
+	defer SetDefaultRateLimit(0)
+
+	SetDefaultRateLimit(10)
+	require.NotNil(t, getDefaultRateLimiter())
+	require.Equal(t, 10.0, getDefaultRateLimiter().rate)
+
+	SetDefaultRateLimit(0)
+	require.Nil(t, getDefaultRateLimiter())
+}
@@ -0,0 +1,85 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxRetries is the number of times requests made by CLI commands
+// are retried after a failure that may be temporary
+const DefaultMaxRetries = 2
+
+// Delays between two attempts of a request. The delay doubles with every
+// attempt, up to maxRetryDelay.
+var (
+	minRetryDelay = 500 * time.Millisecond
+	maxRetryDelay = 5 * time.Second
+)
+
+// shouldRetry reports whether a request should be attempted again after
+// it returned resp or err. The `Stripe-Should-Retry` header of the
+// response takes precedence over its status code.
+func shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx != nil && ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		// Connection errors, unless the request was canceled
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.Header.Get("Stripe-Should-Retry") {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return resp.StatusCode == http.StatusConflict ||
+		resp.StatusCode == http.StatusTooManyRequests ||
+		resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryDelay returns how long to wait before the given retry (starting
+// at 1), with exponential backoff and jitter
+func retryDelay(retry int) time.Duration {
+	delay := minRetryDelay
+	for i := 1; i < retry && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+
+	// Randomly shorten the delay by up to a quarter so that concurrent
+	// clients don't retry in lockstep
+	// #nosec G404
+	delay -= time.Duration(rand.Int63n(int64(delay/4) + 1))
+	if delay < minRetryDelay {
+		delay = minRetryDelay
+	}
+
+	return delay
+}
+
+// sleepBeforeRetry waits before the given retry. It returns false if the
+// context was done first.
+func sleepBeforeRetry(ctx context.Context, retry int) bool {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	timer := time.NewTimer(retryDelay(retry))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
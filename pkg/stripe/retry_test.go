@@ -0,0 +1,137 @@
+package stripe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func withShortRetryDelays(t *testing.T) {
+	min, max := minRetryDelay, maxRetryDelay
+	minRetryDelay, maxRetryDelay = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() { minRetryDelay, maxRetryDelay = min, max })
+}
+
+func TestPerformRequest_RetriesWithSameIdempotencyKey(t *testing.T) {
+	withShortRetryDelays(t)
+
+	var keys []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		if len(keys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"id": "cus_123"}`))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL)
+	client := Client{BaseURL: baseURL, MaxRetries: 2}
+
+	resp, err := client.PerformRequest(context.Background(), http.MethodPost, "/v1/customers", "name=bender", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Len(t, keys, 3)
+	require.NotEmpty(t, keys[0])
+	require.Equal(t, keys[0], keys[1])
+	require.Equal(t, keys[0], keys[2])
+}
+
+func TestPerformRequest_KeepsIdempotencyKey(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "my-key", r.Header.Get("Idempotency-Key"))
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL)
+	client := Client{BaseURL: baseURL, MaxRetries: 2}
+
+	resp, err := client.PerformRequest(context.Background(), http.MethodPost, "/v1/customers", "", func(req *http.Request) {
+		req.Header.Set("Idempotency-Key", "my-key")
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+}
+
+func TestPerformRequest_NoRetries(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		require.Empty(t, r.Header.Get("Idempotency-Key"))
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL)
+	client := Client{BaseURL: baseURL}
+
+	resp, err := client.PerformRequest(context.Background(), http.MethodPost, "/v1/customers", "", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	require.Equal(t, 1, requests)
+}
+
+func TestPerformRequest_StripeShouldRetryFalse(t *testing.T) {
+	withShortRetryDelays(t)
+
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Stripe-Should-Retry", "false")
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	baseURL, _ := url.Parse(ts.URL)
+	client := Client{BaseURL: baseURL, MaxRetries: 2}
+
+	resp, err := client.PerformRequest(context.Background(), http.MethodGet, "/v1/customers", "", nil)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, 1, requests)
+}
+
+func TestShouldRetry(t *testing.T) {
+	response := func(status int, shouldRetry string) *http.Response {
+		resp := &http.Response{StatusCode: status, Header: http.Header{}}
+		if shouldRetry != "" {
+			resp.Header.Set("Stripe-Should-Retry", shouldRetry)
+		}
+		return resp
+	}
+
+	ctx := context.Background()
+	require.True(t, shouldRetry(ctx, nil, errors.New("connection reset by peer")))
+	require.False(t, shouldRetry(ctx, nil, context.Canceled))
+	require.True(t, shouldRetry(ctx, response(http.StatusTooManyRequests, ""), nil))
+	require.True(t, shouldRetry(ctx, response(http.StatusBadGateway, ""), nil))
+	require.True(t, shouldRetry(ctx, response(http.StatusBadRequest, "true"), nil))
+	require.False(t, shouldRetry(ctx, response(http.StatusServiceUnavailable, "false"), nil))
+	require.False(t, shouldRetry(ctx, response(http.StatusBadRequest, ""), nil))
+	require.False(t, shouldRetry(ctx, response(http.StatusOK, ""), nil))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, shouldRetry(canceled, response(http.StatusServiceUnavailable, ""), nil))
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.GreaterOrEqual(t, retryDelay(1), minRetryDelay)
+	require.LessOrEqual(t, retryDelay(1), minRetryDelay)
+	require.Greater(t, retryDelay(2), minRetryDelay)
+	require.LessOrEqual(t, retryDelay(2), 2*minRetryDelay)
+	require.LessOrEqual(t, retryDelay(10), maxRetryDelay)
+	require.GreaterOrEqual(t, retryDelay(10), maxRetryDelay*3/4)
+}